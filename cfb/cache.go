@@ -0,0 +1,50 @@
+package cfb
+
+import "sync"
+
+// sectorCache keep a bounded number of sectors in memory, the oldest sector is evicted first.
+// A nil cache is valid and keep nothing. The cache is safe for concurrent use.
+type sectorCache struct {
+	lock    sync.Mutex
+	limit   int
+	sectors map[uint32]Sector
+	order   []uint32
+}
+
+func newSectorCache(limit int) *sectorCache {
+	return &sectorCache{
+		limit:   limit,
+		sectors: make(map[uint32]Sector, limit),
+	}
+}
+
+func (c *sectorCache) get(sID uint32) Sector {
+	if c == nil {
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.sectors[sID]
+}
+
+func (c *sectorCache) put(sID uint32, s Sector) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, found := c.sectors[sID]; found {
+		return
+	}
+
+	if len(c.order) >= c.limit {
+		delete(c.sectors, c.order[0])
+		c.order = c.order[1:]
+	}
+
+	c.sectors[sID] = s
+	c.order = append(c.order, sID)
+}
@@ -33,6 +33,11 @@ const (
 	NOSTREAM  uint32 = 0xFFFFFFFF // (-1) unallocated directory entry
 )
 
+const (
+	HeaderSize    = 512 // Size of the serialized header, the remainder of the first sector is zero padded.
+	DirectorySize = 128 // Size of a serialized directory entry.
+)
+
 var Signature = [8]byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
 var CLSID_NULL GUID
 
@@ -49,8 +54,26 @@ var (
 	ErrNameLength       = errors.New("Invalid name property length")
 	ErrSeekIndex        = errors.New("Bad seek index")
 	ErrSectorSize       = errors.New("Bad sector read")
+	ErrSectorID         = errors.New("Sector ID out of range")
 	ErrRootSibling      = errors.New("Root directory has illegal siblings")
 	ErrNotStream        = errors.New("Entry is not a stream")
-	// ErrDirectorSectors            = errors.New("Incorrect number of directory sectors")
+	ErrNotStorage       = errors.New("Entry is not a storage")
+	ErrNotFound         = errors.New("Entry not found")
+	ErrName             = errors.New("Invalid character in entry name")
+	ErrNameExists       = errors.New("Entry name already exists")
+	ErrRootEntry        = errors.New("Not allowed on the root entry")
+	ErrByteOrder        = errors.New("Bad byte order mark")
+	ErrFATCount         = errors.New("Header FAT sector count does not match the DIFAT")
+	ErrFATSector        = errors.New("FAT sector not marked as FATSECT")
+	ErrDIFATSector      = errors.New("DIFAT sector not marked as DIFSECT")
+	ErrChainCycle       = errors.New("Sector chain contains a cycle")
+	ErrChainShared      = errors.New("Sector used by more than one chain")
+	ErrOrphanSector     = errors.New("Allocated sector not used by any chain")
+	ErrStreamSize       = errors.New("Stream size does not match the sector chain")
+	ErrDirectoryID      = errors.New("Directory entry ID out of range")
+	ErrDirectoryCycle   = errors.New("Directory tree contains a cycle")
+	ErrDirectoryOrder   = errors.New("Directory siblings are not ordered")
+	ErrDirectoryCount   = errors.New("Incorrect number of directory sectors")
+	ErrRedBlack         = errors.New("Directory siblings violate the red-black tree properties")
 	// ErrTransactionSignatureNumber = errors.New("TransactionSignatureNumber is not zero")
 )
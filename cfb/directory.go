@@ -1,8 +1,9 @@
 package cfb
 
 import (
-	"fmt"
+	"strings"
 	"unicode"
+	"unicode/utf16"
 )
 
 // Directory is an OLE directory entry header. Names are not really kept here as they are not clearly descibing the field.
@@ -31,7 +32,6 @@ func (d *Directory) Validate() (err error) {
 	// STGTY_ROOT      uint8 = 5 // element is a root storage
 
 	if d.Type > STGTY_ROOT {
-		fmt.Printf("Unexpected type: d.Type=%d\n", d.Type)
 		return ErrStorageType
 	}
 
@@ -47,13 +47,41 @@ func (d *Directory) Validate() (err error) {
 }
 
 func (d *Directory) Name() (name string) {
-	if d.NameLength <= 0 {
-		return ""
-	}
-
+	end := d.nameEnd()
 	offset := 0
-	if !unicode.IsPrint(rune(d.NameUTF16[0])) {
+	if end > 0 && !unicode.IsPrint(rune(d.NameUTF16[0])) {
 		offset = 1
 	}
-	return UTF16String(d.NameUTF16[offset : (d.NameLength>>1)-1])
+	return UTF16String(d.NameUTF16[offset:end])
+}
+
+// SetName set the entry name. Names are limited to 31 UTF-16 characters and cannot contain '/', '\', ':' or '!'.
+func (d *Directory) SetName(name string) (err error) {
+	if strings.ContainsAny(name, "/\\:!") {
+		return ErrName
+	}
+
+	encoded := utf16.Encode([]rune(name))
+	if len(encoded) == 0 || len(encoded) >= len(d.NameUTF16) {
+		return ErrNameLength
+	}
+
+	d.NameUTF16 = [32]uint16{}
+	copy(d.NameUTF16[:], encoded)
+	d.NameLength = uint16(len(encoded)+1) << 1
+	return
+}
+
+// rawName return the full entry name, including any leading non-printable character.
+func (d *Directory) rawName() string {
+	return UTF16String(d.NameUTF16[:d.nameEnd()])
+}
+
+// nameEnd return the number of name characters, excluding the terminator. Damaged lengths, ie. odd, below 2 or
+// beyond the name field, give 0.
+func (d *Directory) nameEnd() int {
+	if d.NameLength < 2 || d.NameLength > 64 || d.NameLength%2 != 0 {
+		return 0
+	}
+	return int(d.NameLength>>1) - 1
 }
@@ -1,7 +1,11 @@
 package cfb
 
 import (
+	"bytes"
 	"encoding/binary"
+	"io"
+	"strings"
+	"time"
 )
 
 type DirectoryEntry struct {
@@ -12,6 +16,10 @@ type DirectoryEntry struct {
 	doc      *Document
 	parent   *DirectoryEntry
 	children []*DirectoryEntry
+
+	buffered bool // Stream content is kept in data rather than in the document sectors
+	data     []byte
+	damage   *Damage
 }
 
 func NewDirectoryEntry(d *Document, s *Stream) (dir *DirectoryEntry, err error) {
@@ -31,6 +39,41 @@ func (d *DirectoryEntry) Level() uint32 {
 	return d.level
 }
 
+// ClassID return the CLSID of a storage, CLSID_NULL if not set.
+func (d *DirectoryEntry) ClassID() GUID {
+	return d.CLSID
+}
+
+// Created return the creation time, the zero time if not set. Only storages have creation times.
+func (d *DirectoryEntry) Created() time.Time {
+	return d.Time[0].Time()
+}
+
+// Modified return the modification time, the zero time if not set. Only storages have modification times.
+func (d *DirectoryEntry) Modified() time.Time {
+	return d.Time[1].Time()
+}
+
+// StateBits return the user defined state bits of a storage.
+func (d *DirectoryEntry) StateBits() uint32 {
+	return d.UserFlags
+}
+
+// Color return the red-black tree color of the entry, DE_RED or DE_BLACK.
+func (d *DirectoryEntry) Color() byte {
+	return d.Flags
+}
+
+// Siblings return the IDs of the left and right sibling entries, NOSTREAM if none.
+func (d *DirectoryEntry) Siblings() (left, right uint32) {
+	return d.LeftSibling, d.RightSibling
+}
+
+// ChildID return the ID of the root of the child tree, NOSTREAM if none.
+func (d *DirectoryEntry) ChildID() uint32 {
+	return d.Child
+}
+
 func (d *DirectoryEntry) FullName() string {
 	if d.Parent() == nil || d.Parent().Parent() == nil { // Prevent "Root Entry"
 		return d.Name()
@@ -39,6 +82,18 @@ func (d *DirectoryEntry) FullName() string {
 	return d.Parent().Name() + "/" + d.Name()
 }
 
+// Path return the slash separated path of the entry, relative to the root entry.
+func (d *DirectoryEntry) Path() string {
+	if d.Parent() == nil {
+		return ""
+	}
+
+	if d.Parent().Parent() == nil {
+		return d.Name()
+	}
+	return d.Parent().Path() + "/" + d.Name()
+}
+
 func (d *DirectoryEntry) Parent() *DirectoryEntry {
 	return d.parent
 }
@@ -47,6 +102,31 @@ func (d *DirectoryEntry) Children() []*DirectoryEntry {
 	return d.children
 }
 
+// Lookup resolve a slash separated path relative to this entry.
+// Names are matched case insensitive, with or without any leading non-printable character (eg. "\x05SummaryInformation").
+func (d *DirectoryEntry) Lookup(path string) (entry *DirectoryEntry, err error) {
+	entry = d
+	for _, name := range strings.Split(path, "/") {
+		if name == "" {
+			continue
+		}
+
+		if entry = entry.child(name); entry == nil {
+			return nil, ErrNotFound
+		}
+	}
+	return
+}
+
+func (d *DirectoryEntry) child(name string) *DirectoryEntry {
+	for _, child := range d.children {
+		if CompareNames(child.rawName(), name) == 0 || CompareNames(child.Name(), name) == 0 {
+			return child
+		}
+	}
+	return nil
+}
+
 func (d *DirectoryEntry) Walk(f func(d *DirectoryEntry)) {
 	f(d)
 
@@ -56,10 +136,133 @@ func (d *DirectoryEntry) Walk(f func(d *DirectoryEntry)) {
 }
 
 func (d *DirectoryEntry) Stream() (s *Stream, err error) {
-	if d.Type == STGTY_STREAM {
-		s, err = d.doc.stream(d.Start, d.Size)
-	} else {
+	if d.Type != STGTY_STREAM {
 		err = ErrNotStream
+	} else if d.buffered {
+		s = newBufferStream(d.data)
+	} else if d.Size == 0 {
+		s = newBufferStream(nil)
+	} else if s, err = d.doc.stream(d.Start, d.Size); s != nil && d.doc.recovery {
+		err = nil // The loss is reported by Damage
+	}
+	return
+}
+
+// Damage return what was lost of the stream content in recovery mode, nil if the stream is intact.
+func (d *DirectoryEntry) Damage() *Damage {
+	return d.damage
+}
+
+// AddStorage create a new, empty, storage as child of this storage.
+func (d *DirectoryEntry) AddStorage(name string) (dir *DirectoryEntry, err error) {
+	return d.add(name, STGTY_STORAGE, nil)
+}
+
+// AddStream create a new stream as child of this storage. The data is kept in memory until the document is written.
+func (d *DirectoryEntry) AddStream(name string, data []byte) (dir *DirectoryEntry, err error) {
+	return d.add(name, STGTY_STREAM, data)
+}
+
+// SetContent replace the content of a stream. The data is kept in memory until the document is written.
+func (d *DirectoryEntry) SetContent(data []byte) (err error) {
+	if d.Type != STGTY_STREAM {
+		return ErrNotStream
+	}
+
+	d.buffered = true
+	d.data = data
+	d.Size = uint32(len(data))
+	d.damage = nil
+	return
+}
+
+// Rename change the name of the entry, the name must be unique among its siblings.
+func (d *DirectoryEntry) Rename(name string) (err error) {
+	if d.parent == nil {
+		return ErrRootEntry
+	}
+
+	for _, sibling := range d.parent.children {
+		if sibling != d && CompareNames(sibling.rawName(), name) == 0 {
+			return ErrNameExists
+		}
+	}
+	return d.SetName(name)
+}
+
+// Delete remove the entry, and all its children, from its parent storage.
+// Sectors of the entry are released when the document is written.
+func (d *DirectoryEntry) Delete() (err error) {
+	if d.parent == nil {
+		return ErrRootEntry
+	}
+
+	siblings := d.parent.children
+	for i, sibling := range siblings {
+		if sibling == d {
+			d.parent.children = append(siblings[:i:i], siblings[i+1:]...)
+			break
+		}
+	}
+	d.parent = nil
+	return
+}
+
+func (d *DirectoryEntry) add(name string, objectType byte, data []byte) (dir *DirectoryEntry, err error) {
+	if d.Type != STGTY_STORAGE && d.Type != STGTY_ROOT {
+		return nil, ErrNotStorage
+	}
+
+	for _, child := range d.children {
+		if CompareNames(child.rawName(), name) == 0 {
+			return nil, ErrNameExists
+		}
+	}
+
+	dir = newEntry(d.doc, objectType)
+	if err = dir.SetName(name); err != nil {
+		return nil, err
+	}
+
+	if objectType == STGTY_STREAM {
+		dir.buffered = true
+		dir.data = data
+		dir.Size = uint32(len(data))
 	}
+
+	dir.parent = d
+	dir.level = d.level + 1
+	d.children = append(d.children, dir)
+	return
+}
+
+// content return the full stream content.
+func (d *DirectoryEntry) content() (b []byte, err error) {
+	if d.buffered {
+		return d.data, nil
+	}
+
+	s, err := d.Stream()
+	if err != nil {
+		return
+	}
+
+	var buf bytes.Buffer
+	if _, err = io.Copy(&buf, s); err == nil {
+		b = buf.Bytes()
+	}
+	return
+}
+
+func newEntry(doc *Document, objectType byte) (dir *DirectoryEntry) {
+	dir = new(DirectoryEntry)
+	dir.doc = doc
+	dir.id = NOSTREAM
+	dir.Type = objectType
+	dir.Flags = DE_BLACK
+	dir.LeftSibling = NOSTREAM
+	dir.RightSibling = NOSTREAM
+	dir.Child = NOSTREAM
+	dir.Start = ENDOFCHAIN
 	return
 }
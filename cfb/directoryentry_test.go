@@ -0,0 +1,113 @@
+package cfb
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestModify(t *testing.T) {
+	d, _ := New()
+	root, _ := d.Root()
+	storage, _ := root.AddStorage("Storage")
+	storage.AddStream("Large", testContent(100000, 1))
+	root.AddStream("Body", testContent(3000, 2))
+	root.AddStream("Other", testContent(200, 3))
+
+	filename := filepath.Join(t.TempDir(), "test.cfb")
+	if err := d.WriteFile(filename); err != nil {
+		t.Fatalf("WriteFile: unexpected error [%v]", err)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	defer f.Close()
+	fi, _ := f.Stat()
+
+	r, err := Open(f, fi.Size())
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+
+	rroot, _ := r.Root()
+	errorTests := []struct {
+		got, expect error
+	}{
+		{rroot.Delete(), ErrRootEntry},
+		{rroot.Rename("Other Root"), ErrRootEntry},
+		{rroot.SetContent(nil), ErrNotStream},
+	}
+	for testId, test := range errorTests {
+		if test.got != test.expect {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.expect, test.got)
+		}
+	}
+
+	body, _ := r.Lookup("Body")
+	if err = body.SetContent([]byte("Redacted")); err != nil {
+		t.Errorf("SetContent: unexpected error [%v]", err)
+	}
+
+	other, _ := r.Lookup("Other")
+	if err = other.Rename("body"); err != ErrNameExists {
+		t.Errorf("Expected error [%v], got [%v]", ErrNameExists, err)
+	}
+	if err = other.Rename("Renamed"); err != nil {
+		t.Errorf("Rename: unexpected error [%v]", err)
+	}
+
+	large, _ := r.Lookup("Storage")
+	if err = large.Delete(); err != nil {
+		t.Errorf("Delete: unexpected error [%v]", err)
+	}
+	rroot.AddStream("Added", []byte("New"))
+
+	if err = r.WriteFile(filename); err != nil {
+		t.Fatalf("WriteFile: unexpected error [%v]", err)
+	}
+
+	w, err := NewFromFile(filename)
+	if err != nil {
+		t.Fatalf("NewFromFile: unexpected error [%v]", err)
+	}
+
+	if wfi, _ := os.Stat(filename); wfi.Size() >= fi.Size() {
+		t.Errorf("Expected repacked file smaller than [%d], got [%d]", fi.Size(), wfi.Size())
+	}
+
+	if report, _ := w.Validate(); !report.Valid() {
+		t.Errorf("Expected valid document, got %v", report.Findings)
+	}
+
+	tests := []struct {
+		path   string
+		expect []byte
+	}{
+		{"Body", []byte("Redacted")},
+		{"Renamed", testContent(200, 3)},
+		{"Added", []byte("New")},
+		{"Other", nil},
+		{"Storage", nil},
+	}
+
+	for testId, test := range tests {
+		entry, err := w.Lookup(test.path)
+		if test.expect == nil {
+			if err != ErrNotFound {
+				t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, ErrNotFound, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Test [%d]: unexpected error [%v]", testId, err)
+			continue
+		}
+		if got, _ := entry.content(); !bytes.Equal(got, test.expect) {
+			t.Errorf("Test [%d]: Expected [%d] bytes, got [%d] bytes", testId, len(test.expect), len(got))
+		}
+	}
+}
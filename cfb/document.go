@@ -2,11 +2,19 @@ package cfb
 
 import (
 	"encoding/binary"
-	"fmt"
 	"io"
 	"os"
+	"sync"
 )
 
+// Document is a compound file.
+//
+// Once read, with Open, OpenRecovery or ReadFrom followed by Root, a document is safe for concurrent reading: Root,
+// Lookup, Walk, Listing, Validate and DirectoryEntry.Stream may be called from multiple goroutines. Each call to Stream
+// return an independent Stream with its own offset. A single Stream must not be shared for Read and Seek, but ReadAt is
+// safe for concurrent use. The directory is read once, guarded by a sync.Once, so concurrent first calls to Root are safe.
+// Modifications (AddStream, SetContent, Rename, Delete etc), SetCache, SetRecovery and ReadFrom must not run concurrently
+// with any other use of the document.
 type Document struct {
 	Header
 
@@ -18,12 +26,33 @@ type Document struct {
 	minifat        []uint32
 	ministream     *Stream
 	root           *DirectoryEntry
+	rootOnce       sync.Once // Guard reading of root, minifat and ministream
+	rootErr        error
+
+	reader io.ReaderAt // Source of sectors when opened with Open, otherwise sectors are used
+	size   int64
+	cache  *sectorCache
+
+	recovery bool      // Tolerate damaged structures, see SetRecovery
+	losses   []Finding // Structures lost in recovery mode
 }
 
+// New create an empty version 3 document, ie. with 512 byte sectors.
 func New() (d *Document, err error) {
+	return NewVersion(3)
+}
+
+// NewVersion create an empty document of the major version 3 (512 byte sectors) or 4 (4096 byte sectors).
+func NewVersion(version uint16) (d *Document, err error) {
+	if version != 3 && version != 4 {
+		return nil, ErrVersion
+	}
+
 	d = new(Document)
 
-	d.MajorVersion = 3
+	d.Signature = Signature
+	d.ByteOrder = 0xFFFE
+	d.MajorVersion = version
 	d.MinorVersion = 0x003E
 
 	d.SectorShift = 9
@@ -36,6 +65,9 @@ func New() (d *Document, err error) {
 
 	d.initDocument()
 
+	d.root = newEntry(d, STGTY_ROOT)
+	err = d.root.SetName("Root Entry")
+
 	return
 }
 
@@ -54,34 +86,106 @@ func NewFromFile(filename string) (d *Document, err error) {
 	return
 }
 
+// Open a document for lazy reading. Only the header, FAT, mini FAT and directory are read initially,
+// stream sectors are read from r when the stream is read. The reader must be kept open while the document is used.
+func Open(r io.ReaderAt, size int64) (d *Document, err error) {
+	d = new(Document)
+	err = d.open(r, size)
+	return
+}
+
+func (d *Document) open(r io.ReaderAt, size int64) (err error) {
+	if err = binary.Read(io.NewSectionReader(r, 0, HeaderSize), binary.LittleEndian, &d.Header); err != nil {
+		return
+	}
+
+	d.initDocument()
+	d.reader = r
+	d.size = size
+
+	var fatSectors []uint32
+	if fatSectors, err = d.fatSectorIDs(); err != nil {
+		return
+	}
+
+	if err = d.buildFAT(fatSectors); err != nil {
+		return
+	}
+
+	_, err = d.Root()
+	return
+}
+
+// SetCache set the number of sectors kept in memory for documents opened with Open. Zero disable the cache.
+func (d *Document) SetCache(sectors int) {
+	d.cache = nil
+	if sectors > 0 {
+		d.cache = newSectorCache(sectors)
+	}
+}
+
 func (d *Document) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.LittleEndian, &d.Header); err != nil {
 		return
 	}
+	n = HeaderSize
 
 	d.initDocument()
-	if n, err = d.readSectors(r); err != nil {
+	d.root = nil
+	d.rootOnce = sync.Once{}
+	d.rootErr = nil
+	d.reader = nil
+
+	// The header fill the first sector, ie. version 4 documents have 3584 bytes of padding.
+	padding, err := io.CopyN(io.Discard, r, int64(d.sectorSize)-HeaderSize)
+	n += padding
+	if err != nil {
+		if err == io.EOF {
+			err = ErrSectorSize
+		}
 		return
 	}
 
-	if err = d.buildFAT(d.Fat[:]); err != nil {
+	read, err := d.readSectors(r)
+	n += read
+	if err != nil {
+		return
+	}
+
+	var fatSectors []uint32
+	if fatSectors, err = d.fatSectorIDs(); err != nil {
+		return
+	}
+
+	if err = d.buildFAT(fatSectors); err != nil {
 		return
 	}
 
 	return
 }
 
+// Root return the root entry. The directory is read by the first call, later calls return the same root, or error.
 func (d *Document) Root() (root *DirectoryEntry, err error) {
-	if d.root != nil {
-		return d.root, nil
-	}
+	d.rootOnce.Do(func() {
+		if d.root == nil {
+			d.rootErr = d.readRoot()
+		}
+	})
+	return d.root, d.rootErr
+}
 
+// readRoot read the directory, the mini FAT and the mini stream.
+func (d *Document) readRoot() (err error) {
 	s, err := d.stream(d.SectDirStart, 0)
+	if d.recovery {
+		s, err = d.recoverDirectory(s, err)
+	}
 	if err != nil {
 		return
 	}
 
-	if root, err = NewDirectoryEntry(d, s); err != nil {
+	root, err := NewDirectoryEntry(d, s)
+	if err != nil {
 		return
 	}
 	root.id = 0
@@ -91,12 +195,23 @@ func (d *Document) Root() (root *DirectoryEntry, err error) {
 	}
 
 	if root.LeftSibling != NOSTREAM || root.RightSibling != NOSTREAM {
-		err = ErrRootSibling
+		if !d.recovery {
+			err = ErrRootSibling
+			return
+		}
+		d.lose(ErrRootSibling, FREESECT, 0)
+	}
+
+	visited := map[uint32]bool{0: true}
+	if err = d.entry(s, root, root.Child, visited); err != nil {
 		return
 	}
 
 	d.root = root
-	return root, d.entry(s, root, root.Child)
+	if d.recovery {
+		d.recoverStreams()
+	}
+	return
 }
 
 func (d *Document) Walk(f func(d *DirectoryEntry)) {
@@ -106,45 +221,40 @@ func (d *Document) Walk(f func(d *DirectoryEntry)) {
 	}
 }
 
-func (d *Document) validateFAT(start, end uint32, verbose bool) (count uint32, err error) {
-	initialStart := start
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("Recovered (start, end)=(%d, %d) [%d]:\n%v\n", initialStart, end, start, r)
-		}
-	}()
-
-	if verbose {
-		fmt.Printf("LIST: %d", start)
-	}
-	for ; start <= end; count++ {
-		start = d.fat[start]
-		if verbose {
-			fmt.Printf("->%d", start)
-		}
+// Lookup resolve a slash separated path from the root entry, eg. "__substg1.0_3701000D/__substg1.0_37010102".
+func (d *Document) Lookup(path string) (entry *DirectoryEntry, err error) {
+	root, err := d.Root()
+	if err != nil {
+		return
 	}
-
-	return
+	return root.Lookup(path)
 }
 
-func (d *Document) validateFAT_(prefix string, start uint32, verbose bool) (err error) {
-	var count, sID uint32
-	sID = start
-	if start >= uint32(len(d.fat)) { // Do nothing!
-		fmt.Printf("%s: Validating entries, sID [%d] out of range [0; %d]\n", prefix, sID, len(d.fat))
-		return
+// fatSectorIDs return the IDs of all FAT sectors, the 109 kept in the header followed by those in the DIFAT sector chain.
+func (d *Document) fatSectorIDs() (sectorIDs []uint32, err error) {
+	sectorIDs = append(sectorIDs, d.Fat[:]...)
+
+	difat := make([]uint32, d.sectorSize/4)
+	last := len(difat) - 1
+	sID := d.DifStart
+	for n := uint32(0); n < d.Dif && sID <= MAXREGSECT; n++ {
+		if err = d.readBinary(sID, difat); err != nil {
+			if d.recovery {
+				d.lose(err, sID, NOSTREAM)
+				err = nil
+			}
+			return
+		}
+
+		sectorIDs = append(sectorIDs, difat[:last]...)
+		sID = difat[last]
 	}
 
-	fmt.Printf("%s: Validating entries, sID=%d, len(d.fat)=%d\n", prefix, sID, len(d.fat))
-	count, err = d.validateFAT(sID, MAXREGSECT, verbose)
-	fmt.Printf("%s: %d entries\n", prefix, count)
 	return
 }
 
 func (d *Document) buildFAT(src []uint32) (err error) {
-	d.validateFAT_("buildFAT.a", 1, false)
-
-	var sectorIDs [128]uint32
+	sectorIDs := make([]uint32, d.sectorSize/4)
 	for _, sID := range src {
 		if sID == ENDOFCHAIN {
 			break
@@ -154,19 +264,26 @@ func (d *Document) buildFAT(src []uint32) (err error) {
 			continue
 		}
 
-		if err = d.readBinary(sID, &sectorIDs); err != nil {
-			return
+		if err = d.readBinary(sID, sectorIDs); err != nil {
+			if !d.recovery {
+				return
+			}
+
+			// Keep the position of following FAT sectors, the sectors covered are lost
+			d.lose(err, sID, NOSTREAM)
+			for i := range sectorIDs {
+				sectorIDs[i] = FREESECT
+			}
+			err = nil
 		}
 
-		d.fat = append(d.fat, sectorIDs[:]...)
+		d.fat = append(d.fat, sectorIDs...)
 	}
 
-	if len(d.fat) > len(d.sectors) {
-		d.fat = d.fat[:len(d.sectors)]
+	if count := d.sectorCount(); uint32(len(d.fat)) > count {
+		d.fat = d.fat[:count]
 	}
 
-	d.validateFAT_("buildFAT.b", 1, true)
-
 	return
 }
 
@@ -175,13 +292,21 @@ func (d *Document) buildMiniFAT(streamStart, size uint32) (err error) {
 		return
 	}
 
-	var sectorIDs [128]uint32
-	sID := d.MiniFatStart
-	for ; sID <= MAXREGSECT; sID = d.fat[sID] {
-		if err = d.readBinary(sID, &sectorIDs); err != nil {
+	sIDs, err := d.chain(d.fat, d.MiniFatStart)
+	if err != nil {
+		if !d.recovery {
+			return
+		}
+		d.lose(err, d.MiniFatStart, NOSTREAM)
+		err = nil
+	}
+
+	sectorIDs := make([]uint32, d.sectorSize/4)
+	for _, sID := range sIDs {
+		if err = d.readBinary(sID, sectorIDs); err != nil {
 			return
 		}
-		d.minifat = append(d.minifat, sectorIDs[:]...)
+		d.minifat = append(d.minifat, sectorIDs...)
 	}
 
 	// The minifat is shortened, but has really no need
@@ -191,62 +316,129 @@ func (d *Document) buildMiniFAT(streamStart, size uint32) (err error) {
 	}
 
 	// Prepare the ministream
+	if sIDs, err = d.chain(d.fat, streamStart); err != nil {
+		if !d.recovery {
+			return
+		}
+		d.lose(err, streamStart, 0)
+		err = nil
+	}
+
 	d.ministream = NewStream(d.sectorSize, size)
-	for sID = streamStart; sID <= MAXREGSECT; sID = d.fat[sID] {
-		d.ministream.add(d.sectors[sID], false)
+	d.ministream.doc = d
+	for _, sID := range sIDs {
+		d.ministream.addID(sID, false)
+	}
+	if d.recovery && d.ministream.Sectors()*d.sectorSize < size {
+		d.ministream.size = d.ministream.Sectors() * d.sectorSize
 	}
 
 	return
 }
 
+// stream return the stream of size bytes starting at sector sID, or the directory stream if size is zero.
+// In recovery mode a damaged stream is returned along with the error describing the loss.
 func (d *Document) stream(sID, size uint32) (s *Stream, err error) {
 	s = NewStream(d.sectorSize, size)
 	addSize := s.size == 0
 
 	if 0 < size && size < d.MiniSectorCutoff {
 		s.sectorSize = d.miniSectorSize
-		for n := 0; sID <= MAXREGSECT; sID = d.minifat[sID] {
-			if _, err = d.ministream.Seek(int64(sID*d.miniSectorSize), 0); err != nil {
-				break
-			}
 
+		var sIDs []uint32
+		if d.ministream == nil {
+			err = ErrSectorID
+		} else {
+			sIDs, err = d.chain(d.minifat, sID)
+		}
+		if err != nil && !d.recovery {
+			return nil, err
+		}
+
+		for _, sID := range sIDs {
 			data := make(Sector, d.miniSectorSize)
-			s.add(data, addSize)
-			if n, err = d.ministream.Read(data[:]); err != nil {
-				break
-			} else if uint32(n) != d.miniSectorSize {
-				err = ErrSectorSize
+			if _, readErr := d.ministream.ReadAt(data, int64(sID)*int64(d.miniSectorSize)); readErr != nil {
+				if readErr == io.EOF {
+					readErr = ErrSectorSize
+				}
+				if err == nil { // Keep the chain error, it is the first damage
+					err = readErr
+				}
 				break
 			}
+			s.add(data, addSize)
+		}
+	} else {
+		var sIDs []uint32
+		if sIDs, err = d.chain(d.fat, sID); err != nil && !d.recovery {
+			return nil, err
+		}
+
+		s.doc = d
+		for _, sID := range sIDs {
+			s.addID(sID, addSize)
 		}
-		return
 	}
 
-	// len(d.fat)=13952 > len(d.sectors)=24497
-	// sID  =  1
-	// Last =  4294967290
-	//
-	// panic: runtime error: index out of range [14347] with length 13952
-	// cbf.(*Document).stream()
-	// cbf/document.go:193 		l. 193: "for ; ..." ==>  panic at "d.fat[sID]". Why?
-	for ; sID <= MAXREGSECT; sID = d.fat[sID] {
-		s.add(d.sectors[sID], addSize)
+	if d.recovery && !addSize && s.Sectors()*s.sectorSize < s.size {
+		s.size = s.Sectors() * s.sectorSize
+		if err == nil {
+			err = ErrStreamSize
+		}
+	}
+	return
+}
+
+// chain return the IDs of the sector chain starting at sID.
+// The chain end at ENDOFCHAIN, or any other special value, and fail at out of range sector IDs and cycles.
+func (d *Document) chain(fat []uint32, sID uint32) (sIDs []uint32, err error) {
+	seen := make(map[uint32]bool)
+	for ; sID <= MAXREGSECT; sID = fat[sID] {
+		if sID >= uint32(len(fat)) {
+			return sIDs, ErrSectorID
+		}
+
+		if seen[sID] {
+			return sIDs, ErrChainCycle
+		}
+		seen[sID] = true
+
+		sIDs = append(sIDs, sID)
 	}
 
+	if d.recovery && sID != ENDOFCHAIN {
+		err = ErrSectorID
+	}
 	return
 }
 
-func (d *Document) entry(s *Stream, parent *DirectoryEntry, dirIndex uint32) (err error) {
+func (d *Document) entry(s *Stream, parent *DirectoryEntry, dirIndex uint32, visited map[uint32]bool) (err error) {
 	if dirIndex == NOSTREAM {
 		return
 	}
 
-	if _, err = s.Seek(int64(128*dirIndex), 0); err != nil {
-		return
+	offset := int64(dirIndex) * DirectorySize
+	if visited[dirIndex] {
+		err = ErrDirectoryCycle
+	} else if offset+DirectorySize > s.Size() {
+		err = ErrDirectoryID
+	} else {
+		_, err = s.Seek(offset, io.SeekStart)
 	}
+	visited[dirIndex] = true
 
 	var dir *DirectoryEntry
-	if dir, err = NewDirectoryEntry(d, s); err != nil {
+	if err == nil {
+		dir, err = NewDirectoryEntry(d, s)
+	}
+	if err == nil && d.recovery && dir.Type == STGTY_INVALID {
+		err = ErrStorageType
+	}
+	if err != nil {
+		if d.recovery {
+			d.lose(err, FREESECT, dirIndex)
+			err = nil
+		}
 		return
 	}
 
@@ -257,11 +449,11 @@ func (d *Document) entry(s *Stream, parent *DirectoryEntry, dirIndex uint32) (er
 	}
 	parent.children = append(parent.children, dir)
 
-	if err = d.entry(s, parent, dir.LeftSibling); err != nil {
+	if err = d.entry(s, parent, dir.LeftSibling, visited); err != nil {
 		return
 	}
 
-	if err = d.entry(s, parent, dir.RightSibling); err != nil {
+	if err = d.entry(s, parent, dir.RightSibling, visited); err != nil {
 		return
 	}
 
@@ -269,7 +461,7 @@ func (d *Document) entry(s *Stream, parent *DirectoryEntry, dirIndex uint32) (er
 		return
 	}
 
-	return d.entry(s, dir, dir.Child)
+	return d.entry(s, dir, dir.Child, visited)
 }
 
 func (d *Document) initDocument() {
@@ -282,56 +474,80 @@ func (d *Document) initDocument() {
 	d.miniSectorSize = uint32(1) << d.MiniSectorShift
 }
 
-func (d *Document) doValidate() (err error) {
-	if d.Signature != Signature {
-		return ErrSignature
-	}
+func (d *Document) readSectors(r io.Reader) (n int64, err error) {
+	for read := 0; err == nil; {
+		s := Sector(make([]byte, d.sectorSize))
+		read, err = io.ReadFull(r, s)
+		n += int64(read)
+		if err == io.ErrUnexpectedEOF {
+			if !d.recovery {
+				return n, ErrSectorSize
+			}
+
+			// Truncated last sector, keep it zero padded
+			d.lose(ErrSectorSize, uint32(len(d.sectors)), NOSTREAM)
+			err = nil
+		}
 
-	if d.CLSID != CLSID_NULL {
-		return ErrCLSID
+		if err == nil {
+			d.sectors = append(d.sectors, s)
+		}
 	}
 
-	if (d.MajorVersion != 3 && d.MajorVersion != 4) || d.MinorVersion != 0x003E {
-		return ErrVersion
+	if err == io.EOF {
+		err = nil
 	}
 
-	if (d.MajorVersion == 3 && d.SectorShift != 9) || (d.MajorVersion == 4 && d.SectorShift != 12) {
-		return ErrSectorShift
+	return
+}
+
+// sectorCount return the number of sectors following the header.
+func (d *Document) sectorCount() uint32 {
+	if d.reader == nil {
+		return uint32(len(d.sectors))
 	}
 
-	if d.MiniSectorShift != 6 {
-		return ErrMiniSectorShift
+	headerSize := int64(d.sectorSize)
+	if d.size <= headerSize {
+		return 0
 	}
+	return uint32((d.size - headerSize + int64(d.sectorSize) - 1) / int64(d.sectorSize))
+}
 
-	if d.MiniSectorCutoff != 0x1000 {
-		return ErrMiniSectorCutoff
+// sector return the sector data, from memory or, when opened with Open, from the underlying reader.
+func (d *Document) sector(sID uint32) (s Sector, err error) {
+	if sID >= d.sectorCount() {
+		return nil, ErrSectorID
 	}
 
-	return
-}
+	if d.reader == nil {
+		return d.sectors[sID], nil
+	}
 
-func (d *Document) readSectors(r io.Reader) (n int64, err error) {
-	for read := 0; err == nil; {
-		s := Sector(make([]byte, d.sectorSize))
-		if read, err = r.Read(s); err == nil {
-			if uint32(read) != d.sectorSize {
-				err = ErrSectorSize
-				return
-			}
-			n += int64(read)
-			d.sectors = append(d.sectors, s)
-		}
+	if s = d.cache.get(sID); s != nil {
+		return
 	}
 
-	if err == io.EOF {
+	s = make(Sector, d.sectorSize)
+	n, err := d.reader.ReadAt(s, int64(sID+1)<<d.SectorShift)
+	if err == io.EOF && n > 0 { // Truncated last sector, keep it zero padded
 		err = nil
 	}
+	if err != nil {
+		return nil, err
+	}
 
+	d.cache.put(sID, s)
 	return
 }
 
 func (d *Document) readBinary(sID uint32, target interface{}) (err error) {
-	err = binary.Read(d.sectors[sID].Reader(), d.byteOrder, target)
+	s, err := d.sector(sID)
+	if err != nil {
+		return
+	}
+
+	err = binary.Read(s.Reader(), d.byteOrder, target)
 
 	if err == io.EOF {
 		err = nil
@@ -0,0 +1,242 @@
+package cfb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestReadDIFAT(t *testing.T) {
+	// 110 FAT sectors of 128 entries are needed for more than 109*128 sectors, ie. ~7MB with 512 byte sectors.
+	size := 128*512*112 + 100
+	content := testContent(size, 3)
+
+	d, _ := New()
+	root, _ := d.Root()
+	if _, err := root.AddStream("Large", content); err != nil {
+		t.Fatalf("AddStream: unexpected error [%v]", err)
+	}
+
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	r, _ := New()
+	if _, err := r.ReadFrom(&b); err != nil {
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	if r.Dif == 0 || r.DifStart > MAXREGSECT {
+		t.Errorf("Expected DIFAT sectors, got count [%d] starting at [%d]", r.Dif, r.DifStart)
+	}
+	if r.SectFAT <= uint32(len(r.Fat)) {
+		t.Errorf("Expected more than [%d] FAT sectors, got [%d]", len(r.Fat), r.SectFAT)
+	}
+
+	var got bytes.Buffer
+	r.Walk(func(de *DirectoryEntry) {
+		if de.Name() != "Large" {
+			return
+		}
+
+		s, err := de.Stream()
+		if err != nil {
+			t.Fatalf("Stream: unexpected error [%v]", err)
+		}
+		io.Copy(&got, s)
+	})
+
+	if !bytes.Equal(got.Bytes(), content) {
+		t.Errorf("Content mismatch, expected %d bytes, got %d bytes", len(content), got.Len())
+	}
+}
+
+func TestOpen(t *testing.T) {
+	d, _ := New()
+	root, _ := d.Root()
+	storage, _ := root.AddStorage("Storage")
+	expect := map[string][]byte{
+		"Storage/Mini":  testContent(1000, 1),
+		"Storage/Large": testContent(20000, 2),
+		"Other":         testContent(5000, 3),
+	}
+	storage.AddStream("Mini", expect["Storage/Mini"])
+	storage.AddStream("Large", expect["Storage/Large"])
+	root.AddStream("Other", expect["Other"])
+
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	for _, cache := range []int{0, 2, 100} {
+		r, err := Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatalf("Open: unexpected error [%v]", err)
+		}
+		r.SetCache(cache)
+
+		streams := 0
+		r.Walk(func(de *DirectoryEntry) {
+			if de.Type != STGTY_STREAM {
+				return
+			}
+			streams++
+
+			s, err := de.Stream()
+			if err != nil {
+				t.Fatalf("Cache [%d]: stream [%s] unexpected error [%v]", cache, de.FullName(), err)
+			}
+
+			var got bytes.Buffer
+			io.Copy(&got, s)
+			if !bytes.Equal(got.Bytes(), expect[de.FullName()]) {
+				t.Errorf("Cache [%d]: stream [%s] content mismatch, got %d bytes", cache, de.FullName(), got.Len())
+			}
+		})
+
+		if streams != 3 {
+			t.Errorf("Cache [%d]: expected 3 streams, got %d", cache, streams)
+		}
+	}
+}
+
+func TestVersion4(t *testing.T) {
+	if _, err := NewVersion(5); err != ErrVersion {
+		t.Errorf("Expected error [%v], got [%v]", ErrVersion, err)
+	}
+
+	d, err := NewVersion(4)
+	if err != nil {
+		t.Fatalf("NewVersion: unexpected error [%v]", err)
+	}
+
+	root, _ := d.Root()
+	storage, _ := root.AddStorage("Storage")
+	expect := map[string][]byte{
+		"Mini":          testContent(100, 1),
+		"Storage/Large": testContent(50000, 2),
+	}
+	root.AddStream("Mini", expect["Mini"])
+	storage.AddStream("Large", expect["Storage/Large"])
+
+	// More than 1024 FAT entries, ie. 2 FAT sectors of 4096 bytes.
+	for i := 0; i < 40; i++ {
+		name := fmt.Sprintf("Stream%d", i)
+		expect[name] = testContent(110000, byte(i))
+		root.AddStream(name, expect[name])
+	}
+
+	var b bytes.Buffer
+	n, err := d.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+	if n%4096 != 0 {
+		t.Errorf("Expected length multiple of 4096, got [%d]", n)
+	}
+
+	r, _ := New()
+	if read, err := r.ReadFrom(bytes.NewReader(b.Bytes())); err != nil || read != n {
+		t.Fatalf("ReadFrom: Expected [%d] bytes, got [%d] bytes, error [%v]", n, read, err)
+	}
+
+	o, err := Open(bytes.NewReader(b.Bytes()), n)
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+
+	for testId, doc := range []*Document{r, o} {
+		if doc.MajorVersion != 4 || doc.sectorSize != 4096 || doc.SectFAT != 2 || doc.SectDir != 2 {
+			t.Errorf("Test [%d]: Expected version 4 header, got version [%d] sector size [%d] FAT [%d] directory [%d]",
+				testId, doc.MajorVersion, doc.sectorSize, doc.SectFAT, doc.SectDir)
+		}
+
+		if report, err := doc.Validate(); err != nil || !report.Valid() {
+			t.Errorf("Test [%d]: Expected valid document, got %v error [%v]", testId, report.Findings, err)
+		}
+
+		for name, content := range expect {
+			entry, err := doc.Lookup(name)
+			if err != nil {
+				t.Errorf("Test [%d]: Lookup [%s] unexpected error [%v]", testId, name, err)
+				continue
+			}
+			if got, _ := entry.content(); !bytes.Equal(got, content) {
+				t.Errorf("Test [%d]: stream [%s] expected [%d] bytes, got [%d] bytes", testId, name, len(content), len(got))
+			}
+		}
+	}
+}
+
+// TestReadVersion4 read a version 4 document built sector by sector: FAT, directory and a two sector stream.
+func TestReadVersion4(t *testing.T) {
+	const sectorSize = 4096
+	content := testContent(5000, 7)
+
+	h := Header{
+		Signature:        Signature,
+		MinorVersion:     0x003E,
+		MajorVersion:     4,
+		ByteOrder:        0xFFFE,
+		SectorShift:      12,
+		MiniSectorShift:  6,
+		SectDir:          1,
+		SectFAT:          1,
+		SectDirStart:     1,
+		MiniSectorCutoff: 0x1000,
+		MiniFatStart:     ENDOFCHAIN,
+		DifStart:         ENDOFCHAIN,
+	}
+	for i := range h.Fat {
+		h.Fat[i] = FREESECT
+	}
+	h.Fat[0] = 0
+
+	var b bytes.Buffer
+	binary.Write(&b, binary.LittleEndian, &h)
+	padBuffer(&b, sectorSize)
+
+	fat := make([]uint32, sectorSize/4)
+	for i := range fat {
+		fat[i] = FREESECT
+	}
+	copy(fat, []uint32{FATSECT, ENDOFCHAIN, 3, ENDOFCHAIN})
+	binary.Write(&b, binary.LittleEndian, fat)
+
+	rootEntry := newEntry(nil, STGTY_ROOT)
+	rootEntry.SetName("Root Entry")
+	rootEntry.Child = 1
+	stream := newEntry(nil, STGTY_STREAM)
+	stream.SetName("Stream")
+	stream.Start = 2
+	stream.Size = uint32(len(content))
+	writeDirectory(&b, binary.LittleEndian, &rootEntry.Directory)
+	writeDirectory(&b, binary.LittleEndian, &stream.Directory)
+	padBuffer(&b, sectorSize)
+
+	b.Write(content)
+	padBuffer(&b, sectorSize)
+
+	for testId, cache := range []int{0, 1} {
+		d, err := Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatalf("Test [%d]: Open unexpected error [%v]", testId, err)
+		}
+		d.SetCache(cache)
+
+		entry, err := d.Lookup("Stream")
+		if err != nil {
+			t.Fatalf("Test [%d]: Lookup unexpected error [%v]", testId, err)
+		}
+		if got, _ := entry.content(); !bytes.Equal(got, content) {
+			t.Errorf("Test [%d]: Expected [%d] bytes, got [%d] bytes", testId, len(content), len(got))
+		}
+		if report, _ := d.Validate(); !report.Valid() {
+			t.Errorf("Test [%d]: Expected valid document, got %v", testId, report.Findings)
+		}
+	}
+}
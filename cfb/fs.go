@@ -0,0 +1,209 @@
+package cfb
+
+import (
+	"io"
+	"io/fs"
+	"sort"
+	"time"
+)
+
+// FS expose the storages and streams of a document as a file system, storages being directories and streams files.
+// Entries are named by DirectoryEntry.Name.
+type FS struct {
+	doc *Document
+}
+
+// FS return the document as a file system, implementing fs.FS, fs.ReadDirFS, fs.ReadFileFS and fs.StatFS.
+func (d *Document) FS() *FS {
+	return &FS{d}
+}
+
+func (f *FS) Open(name string) (fs.File, error) {
+	entry, err := f.lookup("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.Type != STGTY_STREAM {
+		return &dirFile{entry: entry}, nil
+	}
+
+	s, err := entry.Stream()
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	return &file{entry, s}, nil
+}
+
+func (f *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entry, err := f.lookup("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.Type == STGTY_STREAM {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: ErrNotStorage}
+	}
+	return dirEntries(entry), nil
+}
+
+func (f *FS) ReadFile(name string) ([]byte, error) {
+	entry, err := f.lookup("readfile", name)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := entry.content()
+	if err != nil {
+		return nil, &fs.PathError{Op: "readfile", Path: name, Err: err}
+	}
+	return append([]byte(nil), b...), nil
+}
+
+func (f *FS) Stat(name string) (fs.FileInfo, error) {
+	entry, err := f.lookup("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return fileInfo{entry}, nil
+}
+
+func (f *FS) lookup(op, name string) (entry *DirectoryEntry, err error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	if name == "." {
+		entry, err = f.doc.Root()
+	} else {
+		entry, err = f.doc.Lookup(name)
+	}
+
+	if err == ErrNotFound {
+		err = fs.ErrNotExist
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	return
+}
+
+func dirEntries(entry *DirectoryEntry) (entries []fs.DirEntry) {
+	for _, child := range entry.Children() {
+		entries = append(entries, fileInfo{child})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return
+}
+
+// fileInfo implement both fs.FileInfo and fs.DirEntry.
+type fileInfo struct {
+	entry *DirectoryEntry
+}
+
+func (i fileInfo) Name() string {
+	if i.entry.Parent() == nil {
+		return "."
+	}
+	return i.entry.Name()
+}
+
+func (i fileInfo) Size() int64 {
+	return int64(i.entry.Size)
+}
+
+func (i fileInfo) Mode() fs.FileMode {
+	if i.IsDir() {
+		return fs.ModeDir | 0555
+	}
+	return 0444
+}
+
+func (i fileInfo) ModTime() time.Time {
+	return i.entry.Modified()
+}
+
+func (i fileInfo) IsDir() bool {
+	return i.entry.Type != STGTY_STREAM
+}
+
+// Sys return the *DirectoryEntry.
+func (i fileInfo) Sys() interface{} {
+	return i.entry
+}
+
+func (i fileInfo) Type() fs.FileMode {
+	return i.Mode().Type()
+}
+
+func (i fileInfo) Info() (fs.FileInfo, error) {
+	return i, nil
+}
+
+type file struct {
+	entry  *DirectoryEntry
+	stream *Stream
+}
+
+func (f *file) Stat() (fs.FileInfo, error) {
+	return fileInfo{f.entry}, nil
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	return f.stream.Read(p)
+}
+
+func (f *file) ReadAt(p []byte, offset int64) (int, error) {
+	return f.stream.ReadAt(p, offset)
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	return f.stream.Seek(offset, whence)
+}
+
+func (f *file) Close() error {
+	return f.stream.Close()
+}
+
+type dirFile struct {
+	entry   *DirectoryEntry
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (f *dirFile) Stat() (fs.FileInfo, error) {
+	return fileInfo{f.entry}, nil
+}
+
+func (f *dirFile) Read(p []byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: f.entry.Path(), Err: ErrNotStream}
+}
+
+func (f *dirFile) Close() error {
+	return nil
+}
+
+func (f *dirFile) ReadDir(n int) (entries []fs.DirEntry, err error) {
+	if f.entries == nil {
+		f.entries = dirEntries(f.entry)
+	}
+
+	rest := f.entries[f.offset:]
+	if n <= 0 {
+		f.offset = len(f.entries)
+		return rest, nil
+	}
+
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+
+	if n > len(rest) {
+		n = len(rest)
+	}
+	f.offset += n
+	return rest[:n], nil
+}
@@ -0,0 +1,131 @@
+package cfb
+
+import (
+	"bytes"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func fsTestDocument(t *testing.T) *Document {
+	w, _ := New()
+	root, _ := w.Root()
+	storage, _ := root.AddStorage("__substg1.0_3701000D")
+	storage.AddStream("__substg1.0_37010102", testContent(5000, 1))
+	storage.AddStream("Empty", nil)
+	root.AddStream("\x05SummaryInformation", testContent(200, 2))
+	root.AddStorage("Nothing")
+
+	var b bytes.Buffer
+	if _, err := w.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	d, err := Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	return d
+}
+
+// damagedNameDocument return a document where the name of the "Empty" stream is cleared, with the given length.
+func damagedNameDocument(t *testing.T, length byte) *Document {
+	w, _ := New()
+	root, _ := w.Root()
+	root.AddStream("Data", testContent(100, 1))
+	root.AddStream("Empty", nil)
+
+	var b bytes.Buffer
+	if _, err := w.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	data := b.Bytes()
+	i := bytes.Index(data, []byte{'E', 0, 'm', 0, 'p', 0, 't', 0, 'y', 0})
+	if i < 0 {
+		t.Fatalf("Entry [Empty] not found")
+	}
+	copy(data[i:i+64], make([]byte, 64))
+	data[i+64], data[i+65] = length, 0
+
+	d, err := Open(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	return d
+}
+
+func TestLookup(t *testing.T) {
+	d := fsTestDocument(t)
+
+	tests := []struct {
+		path string
+		name string
+		err  error
+	}{
+		{"__substg1.0_3701000D/__substg1.0_37010102", "__substg1.0_37010102", nil},
+		{"/__SUBSTG1.0_3701000d/empty", "Empty", nil},
+		{"SummaryInformation", "SummaryInformation", nil},
+		{"\x05SummaryInformation", "SummaryInformation", nil},
+		{"__substg1.0_3701000D/Missing", "", ErrNotFound},
+	}
+
+	for testId, test := range tests {
+		entry, err := d.Lookup(test.path)
+		if err != test.err {
+			t.Errorf("Test [%d]: Expected error [%v], got [%v]", testId, test.err, err)
+		}
+		if err == nil && entry.Name() != test.name {
+			t.Errorf("Test [%d]: Expected entry [%s], got [%s]", testId, test.name, entry.Name())
+		}
+	}
+
+	entry, _ := d.Lookup("__substg1.0_3701000D/Empty")
+	if entry.Path() != "__substg1.0_3701000D/Empty" {
+		t.Errorf("Unexpected path [%s]", entry.Path())
+	}
+}
+
+func TestLookupDamagedName(t *testing.T) {
+	d := damagedNameDocument(t, 2)
+
+	if _, err := d.Lookup("x"); err != ErrNotFound {
+		t.Errorf("Expected [%v], got [%v]", ErrNotFound, err)
+	}
+	if entry, err := d.Lookup("Data"); err != nil || entry.Name() != "Data" {
+		t.Errorf("Expected [Data], got error [%v]", err)
+	}
+
+	var names []string
+	d.Walk(func(de *DirectoryEntry) {
+		names = append(names, de.Name())
+	})
+	if len(names) != 3 {
+		t.Errorf("Expected [3] entries, got %q", names)
+	}
+
+	for testId, length := range []uint16{0, 1, 3, 66, 0xFFFF} {
+		dir := Directory{NameLength: length}
+		if name := dir.Name(); name != "" {
+			t.Errorf("Test [%d]: Expected empty name, got [%s]", testId, name)
+		}
+	}
+}
+
+func TestFS(t *testing.T) {
+	fsys := fsTestDocument(t).FS()
+
+	if err := fstest.TestFS(fsys, "__substg1.0_3701000D/__substg1.0_37010102", "SummaryInformation", "Nothing"); err != nil {
+		t.Errorf("TestFS: %v", err)
+	}
+
+	matches, err := fs.Glob(fsys, "*/__substg1.0_3701*")
+	if err != nil || len(matches) != 1 {
+		t.Errorf("Glob: unexpected result %v, error [%v]", matches, err)
+	}
+
+	b, err := fs.ReadFile(fsys, "__substg1.0_3701000D/__substg1.0_37010102")
+	if err != nil || !bytes.Equal(b, testContent(5000, 1)) {
+		t.Errorf("ReadFile: unexpected content of %d bytes, error [%v]", len(b), err)
+	}
+}
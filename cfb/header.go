@@ -11,7 +11,7 @@ type Header struct {
 	MiniSectorShift  uint16
 	_                uint16 // Reserved, should be 0
 	_                uint32 // Reserved, should be 0
-	_                uint32 // Reserved, should be 0
+	SectDir          uint32 // Number of directory sectors, 0 for version 3
 	SectFAT          uint32
 	SectDirStart     uint32
 	_                uint32 // Transaction signature, should be 0
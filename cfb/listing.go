@@ -0,0 +1,86 @@
+package cfb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var typeNames = []string{"invalid", "storage", "stream", "lockbytes", "property", "root"}
+
+// TypeName return a readable name of a directory entry type, eg. "storage".
+func TypeName(objectType byte) string {
+	if int(objectType) < len(typeNames) {
+		return typeNames[objectType]
+	}
+	return fmt.Sprintf("unknown(%d)", objectType)
+}
+
+// EntryInfo describe a single directory entry of a listing.
+type EntryInfo struct {
+	ID        uint32
+	Level     uint32 // Depth in the tree, 0 for the root entry
+	Path      string // Slash separated path relative to the root entry, "" for the root entry
+	Name      string
+	Type      byte
+	Size      uint32
+	Start     uint32
+	CLSID     GUID
+	StateBits uint32
+	Created   time.Time
+	Modified  time.Time
+	Color     byte
+	Left      uint32
+	Right     uint32
+	Child     uint32
+}
+
+func newEntryInfo(de *DirectoryEntry) (i EntryInfo) {
+	i.ID = de.ID()
+	i.Level = de.Level()
+	i.Path = de.Path()
+	i.Name = de.Name()
+	i.Type = de.Type
+	i.Size = de.Size
+	i.Start = de.Start
+	i.CLSID = de.ClassID()
+	i.StateBits = de.StateBits()
+	i.Created = de.Created()
+	i.Modified = de.Modified()
+	i.Color = de.Color()
+	i.Left, i.Right = de.Siblings()
+	i.Child = de.ChildID()
+	return
+}
+
+// String return a single line description, indented by level.
+func (i EntryInfo) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s%-9s %q size=%d start=%d", strings.Repeat("  ", int(i.Level)), TypeName(i.Type), i.Name, i.Size, i.Start)
+	if i.CLSID != CLSID_NULL {
+		fmt.Fprintf(&b, " clsid=%s", i.CLSID)
+	}
+	if i.StateBits != 0 {
+		fmt.Fprintf(&b, " state=%#x", i.StateBits)
+	}
+	if !i.Created.IsZero() {
+		fmt.Fprintf(&b, " created=%s", i.Created.Format(time.RFC3339))
+	}
+	if !i.Modified.IsZero() {
+		fmt.Fprintf(&b, " modified=%s", i.Modified.Format(time.RFC3339))
+	}
+	return b.String()
+}
+
+// Listing return all directory entries in tree order, ie. each storage followed by its children.
+func (d *Document) Listing() (list []EntryInfo, err error) {
+	root, err := d.Root()
+	if err != nil {
+		return
+	}
+
+	root.Walk(func(de *DirectoryEntry) {
+		list = append(list, newEntryInfo(de))
+	})
+	return
+}
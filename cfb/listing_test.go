@@ -0,0 +1,74 @@
+package cfb
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestListing(t *testing.T) {
+	created := time.Date(2021, 3, 4, 5, 6, 7, 800, time.UTC)
+	modified := created.Add(time.Hour)
+	clsid := GUID{0x00020906, 0, 0, [8]byte{0xC0, 0, 0, 0, 0, 0, 0, 0x46}}
+
+	d, _ := New()
+	root, _ := d.Root()
+	storage, _ := root.AddStorage("Storage")
+	storage.CLSID = clsid
+	storage.UserFlags = 0x42
+	storage.Time = [2]FILETIME{NewFILETIME(created), NewFILETIME(modified)}
+	storage.AddStream("Stream", testContent(100, 1))
+
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	r, err := Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+
+	list, err := r.Listing()
+	if err != nil {
+		t.Fatalf("Listing: unexpected error [%v]", err)
+	}
+
+	tests := []struct {
+		path  string
+		level uint32
+		typ   byte
+		size  uint32
+	}{
+		{"", 0, STGTY_ROOT, 128},
+		{"Storage", 1, STGTY_STORAGE, 0},
+		{"Storage/Stream", 2, STGTY_STREAM, 100},
+	}
+
+	if len(list) != len(tests) {
+		t.Fatalf("Expected [%d] entries, got [%d]", len(tests), len(list))
+	}
+	for testId, test := range tests {
+		got := list[testId]
+		if got.Path != test.path || got.Level != test.level || got.Type != test.typ || got.Size != test.size {
+			t.Errorf("Test [%d]: Expected [%v %d %d %d], got [%v %d %d %d]", testId, test.path, test.level, test.typ, test.size, got.Path, got.Level, got.Type, got.Size)
+		}
+	}
+
+	info := list[1]
+	if info.CLSID != clsid || info.StateBits != 0x42 {
+		t.Errorf("Expected CLSID [%v] and state [0x42], got [%v] and [%#x]", clsid, info.CLSID, info.StateBits)
+	}
+	if !info.Created.Equal(created) || !info.Modified.Equal(modified) {
+		t.Errorf("Expected times [%v %v], got [%v %v]", created, modified, info.Created, info.Modified)
+	}
+	if info.Child != list[2].ID || info.Color != DE_BLACK || info.Left != NOSTREAM || info.Right != NOSTREAM {
+		t.Errorf("Unexpected tree links [%+v]", info)
+	}
+
+	if fi, err := r.FS().Stat("Storage"); err != nil {
+		t.Errorf("Stat: unexpected error [%v]", err)
+	} else if !fi.ModTime().Equal(modified) {
+		t.Errorf("Expected FS modification time [%v], got [%v]", modified, fi.ModTime())
+	}
+}
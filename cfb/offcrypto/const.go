@@ -0,0 +1,50 @@
+package offcrypto
+
+import "errors"
+
+// Stream names
+const (
+	EncryptionInfoName   = "EncryptionInfo"
+	EncryptedPackageName = "EncryptedPackage"
+)
+
+// Standard encryption flags
+const (
+	fCryptoAPI uint32 = 0x04
+	fExternal         = 0x10
+	fAES              = 0x20
+)
+
+// Standard encryption algorithm identifiers
+const (
+	CALG_RC4     uint32 = 0x6801
+	CALG_AES_128        = 0x660E
+	CALG_AES_192        = 0x660F
+	CALG_AES_256        = 0x6610
+	CALG_SHA1           = 0x8004
+)
+
+const (
+	segmentSize   = 4096     // Agile packages are encrypted in segments of 4096 bytes
+	standardSpins = 50000    // Hash iterations of Standard encryption
+	maxSpinCount  = 10000000 // Upper limit of Agile hash iterations
+)
+
+// Agile block keys
+var (
+	blockVerifierHashInput = []byte{0xfe, 0xa7, 0xd2, 0x76, 0x3b, 0x4b, 0x9e, 0x79}
+	blockVerifierHashValue = []byte{0xd7, 0xaa, 0x0f, 0x6d, 0x30, 0x61, 0x34, 0x4e}
+	blockEncryptedKey      = []byte{0x14, 0x6e, 0x0b, 0xe7, 0xab, 0xac, 0xd0, 0xd6}
+)
+
+// URI of the password key encryptor of Agile encryption
+const passwordKeyEncryptor = "http://schemas.microsoft.com/office/2006/keyEncryptor/password"
+
+var (
+	ErrNotEncrypted = errors.New("Document is not encrypted")
+	ErrVersion      = errors.New("Unsupported encryption version")
+	ErrUnsupported  = errors.New("Unsupported encryption algorithm")
+	ErrInfo         = errors.New("Bad encryption info")
+	ErrPassword     = errors.New("Wrong password")
+	ErrPackage      = errors.New("Bad encrypted package")
+)
@@ -0,0 +1,14 @@
+// package offcrypto detect and decrypt password protected Office documents (.docx, .xlsx, .pptx etc).
+// Such documents are compound files holding an "EncryptionInfo" and an "EncryptedPackage" stream, the package being the
+// encrypted OPC (zip) file. Both Standard (AES) and Agile encryption are supported.
+//
+// Example:
+//
+//	d, _ := cfb.NewFromFile("protected.xlsx")
+//	r, err := offcrypto.Decrypt(d, "password")
+//	if err == nil {
+//		doc, err = excel.Open(r, r.Size())
+//	}
+//
+// This package rely on the specification [MS-OFFCRYPTO]: Office Document Cryptography Structure.
+package offcrypto
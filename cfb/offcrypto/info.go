@@ -0,0 +1,240 @@
+package offcrypto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"io"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+var byteOrder = binary.LittleEndian
+
+// Info is a parsed EncryptionInfo stream, either Standard or Agile is set.
+type Info struct {
+	MajorVersion uint16
+	MinorVersion uint16
+	Standard     *StandardInfo
+	Agile        *AgileInfo
+}
+
+// StandardInfo is the header and verifier of Standard encryption.
+type StandardInfo struct {
+	Flags                 uint32
+	AlgID                 uint32
+	AlgIDHash             uint32
+	KeySize               uint32 // In bits
+	ProviderType          uint32
+	CSPName               string
+	Salt                  []byte
+	EncryptedVerifier     []byte
+	VerifierHashSize      uint32
+	EncryptedVerifierHash []byte
+}
+
+// CipherParams describe the cipher and hash of Agile encryption.
+type CipherParams struct {
+	SaltSize        int
+	BlockSize       int
+	KeyBits         int
+	HashSize        int
+	CipherAlgorithm string // Only "AES" is supported
+	CipherChaining  string // Only "ChainingModeCBC" is supported
+	HashAlgorithm   string // "SHA1", "SHA256", "SHA384", "SHA512" or "MD5"
+	SaltValue       []byte
+}
+
+// PasswordKey is the password key encryptor of Agile encryption.
+type PasswordKey struct {
+	CipherParams
+	SpinCount                  int
+	EncryptedVerifierHashInput []byte
+	EncryptedVerifierHashValue []byte
+	EncryptedKeyValue          []byte
+}
+
+// AgileInfo is the XML descriptor of Agile encryption.
+type AgileInfo struct {
+	KeyData     CipherParams
+	PasswordKey PasswordKey
+}
+
+// IsEncrypted return true if the document hold an encrypted package.
+func IsEncrypted(d *cfb.Document) bool {
+	info, err := d.Lookup(EncryptionInfoName)
+	if err != nil || info.Type != cfb.STGTY_STREAM {
+		return false
+	}
+
+	pkg, err := d.Lookup(EncryptedPackageName)
+	return err == nil && pkg.Type == cfb.STGTY_STREAM
+}
+
+// ReadInfo read and parse the EncryptionInfo stream of the document.
+func ReadInfo(d *cfb.Document) (info *Info, err error) {
+	entry, err := d.Lookup(EncryptionInfoName)
+	if err != nil {
+		return nil, ErrNotEncrypted
+	}
+
+	s, err := entry.Stream()
+	if err != nil {
+		return
+	}
+
+	b, err := io.ReadAll(s)
+	if err != nil {
+		return
+	}
+	return ParseInfo(b)
+}
+
+// ParseInfo parse the content of an EncryptionInfo stream.
+func ParseInfo(b []byte) (info *Info, err error) {
+	if len(b) < 8 {
+		return nil, ErrInfo
+	}
+
+	info = new(Info)
+	info.MajorVersion = byteOrder.Uint16(b)
+	info.MinorVersion = byteOrder.Uint16(b[2:])
+
+	switch {
+	case info.MajorVersion == 4 && info.MinorVersion == 4:
+		info.Agile, err = parseAgile(b[8:])
+	case info.MajorVersion >= 2 && info.MajorVersion <= 4 && info.MinorVersion == 2:
+		info.Standard, err = parseStandard(b[4:])
+	default:
+		err = ErrVersion
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func parseStandard(b []byte) (s *StandardInfo, err error) {
+	if len(b) < 8 {
+		return nil, ErrInfo
+	}
+
+	s = new(StandardInfo)
+	s.Flags = byteOrder.Uint32(b)
+	headerSize := byteOrder.Uint32(b[4:])
+	b = b[8:]
+	if headerSize < 32 || uint64(headerSize) > uint64(len(b)) {
+		return nil, ErrInfo
+	}
+
+	header := b[:headerSize]
+	s.AlgID = byteOrder.Uint32(header[8:])
+	s.AlgIDHash = byteOrder.Uint32(header[12:])
+	s.KeySize = byteOrder.Uint32(header[16:])
+	s.ProviderType = byteOrder.Uint32(header[20:])
+	s.CSPName = utf16String(header[32:])
+
+	verifier := b[headerSize:]
+	if len(verifier) < 40 {
+		return nil, ErrInfo
+	}
+
+	saltSize := byteOrder.Uint32(verifier)
+	if saltSize != 16 {
+		return nil, ErrInfo
+	}
+	s.Salt = verifier[4:20]
+	s.EncryptedVerifier = verifier[20:36]
+	s.VerifierHashSize = byteOrder.Uint32(verifier[36:])
+	s.EncryptedVerifierHash = verifier[40:]
+	return
+}
+
+type xmlCipherParams struct {
+	SaltSize        int    `xml:"saltSize,attr"`
+	BlockSize       int    `xml:"blockSize,attr"`
+	KeyBits         int    `xml:"keyBits,attr"`
+	HashSize        int    `xml:"hashSize,attr"`
+	CipherAlgorithm string `xml:"cipherAlgorithm,attr"`
+	CipherChaining  string `xml:"cipherChaining,attr"`
+	HashAlgorithm   string `xml:"hashAlgorithm,attr"`
+	SaltValue       string `xml:"saltValue,attr"`
+}
+
+type xmlEncryption struct {
+	KeyData       xmlCipherParams `xml:"keyData"`
+	KeyEncryptors []struct {
+		URI          string `xml:"uri,attr"`
+		EncryptedKey struct {
+			xmlCipherParams
+			SpinCount                  int    `xml:"spinCount,attr"`
+			EncryptedVerifierHashInput string `xml:"encryptedVerifierHashInput,attr"`
+			EncryptedVerifierHashValue string `xml:"encryptedVerifierHashValue,attr"`
+			EncryptedKeyValue          string `xml:"encryptedKeyValue,attr"`
+		} `xml:"encryptedKey"`
+	} `xml:"keyEncryptors>keyEncryptor"`
+}
+
+func parseAgile(b []byte) (a *AgileInfo, err error) {
+	var e xmlEncryption
+	if err = xml.NewDecoder(bytes.NewReader(b)).Decode(&e); err != nil {
+		return nil, ErrInfo
+	}
+
+	a = new(AgileInfo)
+	if a.KeyData, err = e.KeyData.params(); err != nil {
+		return nil, err
+	}
+
+	for _, encryptor := range e.KeyEncryptors {
+		if encryptor.URI != passwordKeyEncryptor {
+			continue
+		}
+
+		key := &encryptor.EncryptedKey
+		p := &a.PasswordKey
+		if p.CipherParams, err = key.params(); err != nil {
+			return nil, err
+		}
+
+		p.SpinCount = key.SpinCount
+		values := []struct {
+			dst *[]byte
+			src string
+		}{
+			{&p.EncryptedVerifierHashInput, key.EncryptedVerifierHashInput},
+			{&p.EncryptedVerifierHashValue, key.EncryptedVerifierHashValue},
+			{&p.EncryptedKeyValue, key.EncryptedKeyValue},
+		}
+		for _, v := range values {
+			if *v.dst, err = base64.StdEncoding.DecodeString(v.src); err != nil {
+				return nil, ErrInfo
+			}
+		}
+		return
+	}
+	return nil, ErrUnsupported // Certificate key encryptors only
+}
+
+func (x *xmlCipherParams) params() (p CipherParams, err error) {
+	p = CipherParams{x.SaltSize, x.BlockSize, x.KeyBits, x.HashSize, x.CipherAlgorithm, x.CipherChaining, x.HashAlgorithm, nil}
+	if p.SaltValue, err = base64.StdEncoding.DecodeString(x.SaltValue); err != nil {
+		err = ErrInfo
+	}
+	return
+}
+
+func utf16String(b []byte) string {
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		c := byteOrder.Uint16(b[i:])
+		if c == 0 {
+			break
+		}
+		u = append(u, c)
+	}
+	return string(utf16.Decode(u))
+}
@@ -0,0 +1,201 @@
+package offcrypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"unicode/utf16"
+)
+
+func newHash(algorithm string) (f func() hash.Hash, err error) {
+	switch algorithm {
+	case "SHA1":
+		f = sha1.New
+	case "SHA256":
+		f = sha256.New
+	case "SHA384":
+		f = sha512.New384
+	case "SHA512":
+		f = sha512.New
+	case "MD5":
+		f = md5.New
+	default:
+		err = ErrUnsupported
+	}
+	return
+}
+
+func sum(newHash func() hash.Hash, parts ...[]byte) []byte {
+	h := newHash()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+func passwordBytes(password string) (b []byte) {
+	for _, c := range utf16.Encode([]rune(password)) {
+		b = append(b, byte(c), byte(c>>8))
+	}
+	return
+}
+
+// hashPassword compute H(salt + password), then iterate H(iterator + hash) spinCount times.
+func hashPassword(newHash func() hash.Hash, salt []byte, password string, spinCount int) (h []byte) {
+	h = sum(newHash, salt, passwordBytes(password))
+	iterator := make([]byte, 4)
+	for i := 0; i < spinCount; i++ {
+		byteOrder.PutUint32(iterator, uint32(i))
+		h = sum(newHash, iterator, h)
+	}
+	return
+}
+
+// fit truncate b, or pad it with 0x36, to size bytes.
+func fit(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b[:size]
+	}
+	return append(append([]byte(nil), b...), bytes.Repeat([]byte{0x36}, size-len(b))...)
+}
+
+// standardKey derive the key of Standard encryption, verifying the password.
+func standardKey(s *StandardInfo, password string) (key []byte, err error) {
+	if s.Flags&fExternal != 0 || s.Flags&fAES == 0 {
+		return nil, ErrUnsupported
+	}
+
+	switch s.AlgID {
+	case CALG_AES_128, CALG_AES_192, CALG_AES_256:
+	default:
+		return nil, ErrUnsupported
+	}
+
+	if s.KeySize%64 != 0 || s.KeySize < 128 || s.KeySize > 256 || s.VerifierHashSize != sha1.Size || len(s.EncryptedVerifierHash) < 32 {
+		return nil, ErrInfo
+	}
+
+	key = deriveStandardKey(s.Salt, password, int(s.KeySize/8))
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return
+	}
+
+	verifier := make([]byte, 16)
+	decryptECB(block, verifier, s.EncryptedVerifier)
+	verifierHash := make([]byte, 32)
+	decryptECB(block, verifierHash, s.EncryptedVerifierHash[:32])
+
+	if !bytes.Equal(sum(sha1.New, verifier), verifierHash[:sha1.Size]) {
+		return nil, ErrPassword
+	}
+	return
+}
+
+func deriveStandardKey(salt []byte, password string, size int) []byte {
+	h := hashPassword(sha1.New, salt, password, standardSpins)
+	h = sum(sha1.New, h, []byte{0, 0, 0, 0})
+
+	x1 := bytes.Repeat([]byte{0x36}, 64)
+	x2 := bytes.Repeat([]byte{0x5C}, 64)
+	for i := range h {
+		x1[i] ^= h[i]
+		x2[i] ^= h[i]
+	}
+	return append(sum(sha1.New, x1), sum(sha1.New, x2)...)[:size]
+}
+
+// agileKey derive the intermediate key of Agile encryption, verifying the password.
+func agileKey(a *AgileInfo, password string) (key []byte, err error) {
+	p := &a.PasswordKey
+	if err = p.check(); err != nil {
+		return
+	}
+	if err = a.KeyData.check(); err != nil {
+		return
+	}
+
+	newHash, _ := newHash(p.HashAlgorithm)
+	h := hashPassword(newHash, p.SaltValue, password, p.SpinCount)
+	iv := fit(p.SaltValue, p.BlockSize)
+
+	decrypt := func(blockKey, encrypted []byte) (b []byte, err error) {
+		block, err := aes.NewCipher(deriveAgileKey(newHash, h, blockKey, p.KeyBits/8))
+		if err != nil {
+			return
+		}
+		if len(encrypted)%block.BlockSize() != 0 {
+			return nil, ErrInfo
+		}
+
+		b = make([]byte, len(encrypted))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(b, encrypted)
+		return
+	}
+
+	input, err := decrypt(blockVerifierHashInput, p.EncryptedVerifierHashInput)
+	if err != nil {
+		return
+	}
+	value, err := decrypt(blockVerifierHashValue, p.EncryptedVerifierHashValue)
+	if err != nil {
+		return
+	}
+
+	if len(input) < p.SaltSize || len(value) < p.HashSize ||
+		!bytes.Equal(fit(sum(newHash, input[:p.SaltSize]), p.HashSize), value[:p.HashSize]) {
+		return nil, ErrPassword
+	}
+
+	if key, err = decrypt(blockEncryptedKey, p.EncryptedKeyValue); err != nil {
+		return
+	}
+	if len(key) < a.KeyData.KeyBits/8 {
+		return nil, ErrInfo
+	}
+	return key[:a.KeyData.KeyBits/8], nil
+}
+
+// deriveAgileKey derive the key for a block key from the password hash.
+func deriveAgileKey(newHash func() hash.Hash, h, blockKey []byte, size int) []byte {
+	return fit(sum(newHash, h, blockKey), size)
+}
+
+// check the password key parameters, rejecting spin counts above the limit of the specification.
+func (p *PasswordKey) check() (err error) {
+	if err = p.CipherParams.check(); err != nil {
+		return
+	}
+	if p.SpinCount < 0 || p.SpinCount > maxSpinCount {
+		return ErrInfo
+	}
+	return
+}
+
+func (c *CipherParams) check() (err error) {
+	if c.CipherAlgorithm != "AES" || c.CipherChaining != "ChainingModeCBC" {
+		return ErrUnsupported
+	}
+	if _, err = newHash(c.HashAlgorithm); err != nil {
+		return
+	}
+	if c.BlockSize != aes.BlockSize || (c.KeyBits != 128 && c.KeyBits != 192 && c.KeyBits != 256) || c.HashSize <= 0 {
+		return ErrInfo
+	}
+	if c.SaltSize <= 0 || len(c.SaltValue) < c.SaltSize {
+		return ErrInfo
+	}
+	return
+}
+
+func decryptECB(block cipher.Block, dst, src []byte) {
+	size := block.BlockSize()
+	for i := 0; i+size <= len(src); i += size {
+		block.Decrypt(dst[i:i+size], src[i:i+size])
+	}
+}
@@ -0,0 +1,158 @@
+package offcrypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"io"
+	"sync"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// Reader decrypt an encrypted package on demand, segment by segment. It is safe for concurrent use.
+type Reader struct {
+	src     io.ReaderAt // Encrypted package, following the 8 byte size
+	srcSize int64
+	size    int64
+	block   cipher.Block
+	iv      func(segment int64) []byte // Agile only, Standard encryption use ECB
+
+	mutex   sync.Mutex
+	segment int64 // Decrypted segment kept in data, -1 if none
+	data    []byte
+}
+
+// Decrypt verify the password and return a reader of the decrypted package, ie. the OPC (zip) file.
+func Decrypt(d *cfb.Document, password string) (r *Reader, err error) {
+	info, err := ReadInfo(d)
+	if err != nil {
+		return
+	}
+
+	entry, err := d.Lookup(EncryptedPackageName)
+	if err != nil {
+		return nil, ErrNotEncrypted
+	}
+
+	s, err := entry.Stream()
+	if err != nil {
+		return
+	}
+	return NewReader(info, password, s, s.Size())
+}
+
+// NewReader verify the password and return a reader of the encrypted package read from src, the content of the
+// EncryptedPackage stream.
+func NewReader(info *Info, password string, src io.ReaderAt, size int64) (r *Reader, err error) {
+	var key []byte
+	var iv func(int64) []byte
+	switch {
+	case info.Standard != nil:
+		key, err = standardKey(info.Standard, password)
+	case info.Agile != nil:
+		if key, err = agileKey(info.Agile, password); err == nil {
+			iv = segmentIV(&info.Agile.KeyData)
+		}
+	default:
+		err = ErrVersion
+	}
+	if err != nil {
+		return
+	}
+
+	r = &Reader{src: src, srcSize: size - 8, iv: iv, segment: -1}
+	if r.block, err = aes.NewCipher(key); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 8)
+	if _, err = src.ReadAt(header, 0); err != nil {
+		return nil, ErrPackage
+	}
+
+	r.size = int64(byteOrder.Uint64(header))
+	if r.size < 0 || r.size > r.srcSize {
+		return nil, ErrPackage
+	}
+	return
+}
+
+func segmentIV(keyData *CipherParams) func(int64) []byte {
+	newHash, _ := newHash(keyData.HashAlgorithm)
+	return func(segment int64) []byte {
+		index := make([]byte, 4)
+		byteOrder.PutUint32(index, uint32(segment))
+		return fit(sum(newHash, keyData.SaltValue, index), keyData.BlockSize)
+	}
+}
+
+// Size return the size of the decrypted package.
+func (r *Reader) Size() int64 {
+	return r.size
+}
+
+func (r *Reader) ReadAt(p []byte, offset int64) (n int, err error) {
+	if offset < 0 {
+		return 0, ErrPackage
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for n < len(p) && offset < r.size {
+		segment := offset / segmentSize
+		if err = r.load(segment); err != nil {
+			return
+		}
+
+		start := offset - segment*segmentSize
+		end := int64(len(r.data))
+		if limit := r.size - segment*segmentSize; end > limit {
+			end = limit
+		}
+		if start >= end {
+			return n, ErrPackage
+		}
+
+		m := copy(p[n:], r.data[start:end])
+		n += m
+		offset += int64(m)
+	}
+
+	if n < len(p) {
+		err = io.EOF
+	}
+	return
+}
+
+// load decrypt a segment into data.
+func (r *Reader) load(segment int64) (err error) {
+	if segment == r.segment {
+		return
+	}
+
+	offset := segment * segmentSize
+	size := r.srcSize - offset
+	if size > segmentSize {
+		size = segmentSize
+	}
+	size -= size % aes.BlockSize
+
+	if r.data == nil {
+		r.data = make([]byte, segmentSize)
+	}
+	r.data = r.data[:size]
+
+	r.segment = -1
+	if n, _ := r.src.ReadAt(r.data, 8+offset); n < len(r.data) {
+		return ErrPackage
+	}
+
+	if r.iv == nil {
+		decryptECB(r.block, r.data, r.data)
+	} else {
+		cipher.NewCBCDecrypter(r.block, r.iv(segment)).CryptBlocks(r.data, r.data)
+	}
+	r.segment = segment
+	return
+}
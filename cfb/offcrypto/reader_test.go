@@ -0,0 +1,303 @@
+package offcrypto
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+func testBytes(size int, seed byte) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = seed + byte(i*13)
+	}
+	return b
+}
+
+func testZip(t *testing.T) []byte {
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	for i := 0; i < 3; i++ {
+		f, _ := w.CreateHeader(&zip.FileHeader{Name: fmt.Sprintf("xl/sheet%d.xml", i), Method: zip.Store})
+		f.Write(testBytes(5000+i, byte(i)))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Zip: unexpected error [%v]", err)
+	}
+	return b.Bytes()
+}
+
+func encryptCBC(key, iv, b []byte) []byte {
+	block, _ := aes.NewCipher(key)
+	out := make([]byte, (len(b)+15)/16*16)
+	copy(out, b)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, out)
+	return out
+}
+
+func encryptECB(key, b []byte) []byte {
+	block, _ := aes.NewCipher(key)
+	out := make([]byte, (len(b)+15)/16*16)
+	copy(out, b)
+	for i := 0; i < len(out); i += 16 {
+		block.Encrypt(out[i:i+16], out[i:i+16])
+	}
+	return out
+}
+
+func packageHeader(size int) []byte {
+	b := make([]byte, 8)
+	byteOrder.PutUint64(b, uint64(size))
+	return b
+}
+
+// agileDocument encrypt content with Agile encryption, SHA512 and AES-256.
+func agileDocument(password string, content []byte) (info, pkg []byte) {
+	keySalt, passwordSalt := testBytes(16, 1), testBytes(16, 2)
+	intermediate := testBytes(32, 3)
+	verifier := testBytes(16, 4)
+
+	const spinCount = 1000
+	h := hashPassword(sha512.New, passwordSalt, password, spinCount)
+	encrypt := func(blockKey, b []byte) string {
+		return base64.StdEncoding.EncodeToString(encryptCBC(deriveAgileKey(sha512.New, h, blockKey, 32), passwordSalt, b))
+	}
+
+	params := `saltSize="16" blockSize="16" keyBits="256" hashSize="64" cipherAlgorithm="AES" cipherChaining="ChainingModeCBC" hashAlgorithm="SHA512"`
+	xml := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<encryption xmlns="http://schemas.microsoft.com/office/2006/encryption" xmlns:p="http://schemas.microsoft.com/office/2006/keyEncryptor/password">
+<keyData %s saltValue="%s"/>
+<keyEncryptors><keyEncryptor uri="http://schemas.microsoft.com/office/2006/keyEncryptor/password">
+<p:encryptedKey spinCount="%d" %s saltValue="%s" encryptedVerifierHashInput="%s" encryptedVerifierHashValue="%s" encryptedKeyValue="%s"/>
+</keyEncryptor></keyEncryptors></encryption>`,
+		params, base64.StdEncoding.EncodeToString(keySalt), spinCount, params, base64.StdEncoding.EncodeToString(passwordSalt),
+		encrypt(blockVerifierHashInput, verifier), encrypt(blockVerifierHashValue, sum(sha512.New, verifier)), encrypt(blockEncryptedKey, intermediate))
+
+	info = append([]byte{4, 0, 4, 0, 0x40, 0, 0, 0}, xml...)
+
+	pkg = packageHeader(len(content))
+	for i := 0; i*segmentSize < len(content); i++ {
+		end := (i + 1) * segmentSize
+		if end > len(content) {
+			end = len(content)
+		}
+		iv := fit(sum(sha512.New, keySalt, []byte{byte(i), byte(i >> 8), 0, 0}), 16)
+		pkg = append(pkg, encryptCBC(intermediate, iv, content[i*segmentSize:end])...)
+	}
+	return
+}
+
+// standardDocument encrypt content with Standard encryption and AES-128.
+func standardDocument(password string, content []byte) (info, pkg []byte) {
+	salt := testBytes(16, 5)
+	verifier := testBytes(16, 6)
+	key := deriveStandardKey(salt, password, 16)
+
+	header := make([]byte, 32)
+	byteOrder.PutUint32(header[0:], fCryptoAPI|fAES)
+	byteOrder.PutUint32(header[8:], CALG_AES_128)
+	byteOrder.PutUint32(header[12:], CALG_SHA1)
+	byteOrder.PutUint32(header[16:], 128)
+	byteOrder.PutUint32(header[20:], 0x18)
+	header = append(header, 'A', 0, 'E', 0, 'S', 0, 0, 0)
+
+	info = []byte{3, 0, 2, 0}
+	info = append(info, header[:4]...)
+	info = append(info, byte(len(header)), 0, 0, 0)
+	info = append(info, header...)
+	info = append(info, 16, 0, 0, 0)
+	info = append(info, salt...)
+	info = append(info, encryptECB(key, verifier)...)
+	info = append(info, sha1.Size, 0, 0, 0)
+	info = append(info, encryptECB(key, sum(sha1.New, verifier))...)
+
+	pkg = append(packageHeader(len(content)), encryptECB(key, content)...)
+	return
+}
+
+func encryptedDocument(t *testing.T, info, pkg []byte) *cfb.Document {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(EncryptionInfoName, info)
+	root.AddStream(EncryptedPackageName, pkg)
+
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	r, err := cfb.Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	return r
+}
+
+func TestDecrypt(t *testing.T) {
+	content := testZip(t)
+	agileInfo, agilePkg := agileDocument("Secret", content)
+	standardInfo, standardPkg := standardDocument("Secret", content)
+
+	tests := []struct {
+		info, pkg []byte
+		password  string
+		err       error
+	}{
+		{agileInfo, agilePkg, "Secret", nil},
+		{agileInfo, agilePkg, "secret", ErrPassword},
+		{standardInfo, standardPkg, "Secret", nil},
+		{standardInfo, standardPkg, "Other", ErrPassword},
+	}
+
+	for testId, test := range tests {
+		d := encryptedDocument(t, test.info, test.pkg)
+		if !IsEncrypted(d) {
+			t.Errorf("Test [%d]: Expected encrypted document", testId)
+		}
+
+		r, err := Decrypt(d, test.password)
+		if err != test.err {
+			t.Errorf("Test [%d]: Expected error [%v], got [%v]", testId, test.err, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		got, err := io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+		if err != nil || !bytes.Equal(got, content) {
+			t.Errorf("Test [%d]: Expected [%d] bytes, got [%d] bytes, error [%v]", testId, len(content), len(got), err)
+		}
+
+		z, err := zip.NewReader(r, r.Size())
+		if err != nil || len(z.File) != 3 {
+			t.Errorf("Test [%d]: zip.NewReader unexpected error [%v]", testId, err)
+		}
+	}
+
+	plain, _ := cfb.New()
+	if IsEncrypted(plain) {
+		t.Errorf("Expected plain document")
+	}
+	if _, err := Decrypt(plain, "Secret"); err != ErrNotEncrypted {
+		t.Errorf("Expected error [%v], got [%v]", ErrNotEncrypted, err)
+	}
+}
+
+func TestAgileLimits(t *testing.T) {
+	info, pkg := agileDocument("Secret", testZip(t))
+
+	tests := []struct {
+		old, new string
+	}{
+		{`saltSize="16"`, `saltSize="-1"`},
+		{`saltSize="16"`, `saltSize="0"`},
+		{`saltSize="16"`, `saltSize="32"`},
+		{`spinCount="1000"`, `spinCount="10000001"`},
+		{`spinCount="1000"`, `spinCount="-1"`},
+	}
+	for testId, test := range tests {
+		d := encryptedDocument(t, bytes.ReplaceAll(info, []byte(test.old), []byte(test.new)), pkg)
+		if _, err := Decrypt(d, "Secret"); err != ErrInfo {
+			t.Errorf("Test [%d]: Expected error [%v], got [%v]", testId, ErrInfo, err)
+		}
+	}
+}
+
+// Known answer vectors, encrypted by an independent implementation of MS-OFFCRYPTO (Python hashlib and OpenSSL)
+// rather than by the functions tested.
+const (
+	knownPassword = "Password1234_"
+	knownContent  = "MS-OFFCRYPTO known answer: the quick brown fox jumps over the lazy dog."
+
+	knownStandardKey  = "6936b71061b537fd80b91a385cb35144"
+	knownStandardInfo = "BAACACQAAACMAAAAJAAAAAAAAAAOZgAABIAAAIAAAAAYAAAAAAAAAAAAAABNAGkAYwByAG8AcwBvAGYAdAAgAEUAbgBoAGEAbgBjAGUAZAAg" +
+		"AFIAUwBBACAAYQBuAGQAIABBAEUAUwAgAEMAcgB5AHAAdABvAGcAcgBhAHAAaABpAGMAIABQAHIAbwB2AGkAZABlAHIAAAAQAAAAnR5ryzof" +
+		"B9jixaBLf2Y54W/elKJKc/LRsTB8vAZLUjQUAAAAxjJGtf3frL3+p5zpnPBeU1t2BSYVvGO/jP43XpSQRI4="
+	knownStandardPackage = "RwAAAAAAAADg/K32iSw1tS1nDwqvxuuDQo881MQPJ+gCt7q/2Et2rImu4rz+/2ui0OCR+KkQY5IT1saEc6MEvtRVBpnIhYn6W+0fpIGP" +
+		"Pdq82gdZXpt6cQ=="
+
+	knownAgileKey = "0f3a9c61e7d24b85a1c9f03e6b7d2a94c8e15f6037b9d4a2e6c1083f5b9a7d21"
+	knownAgileXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\r\n" +
+		`<encryption xmlns="http://schemas.microsoft.com/office/2006/encryption" xmlns:p="http://schemas.microsoft.com/office/2006/keyEncryptor/password">` +
+		`<keyData saltSize="16" blockSize="16" keyBits="256" hashSize="64" cipherAlgorithm="AES" cipherChaining="ChainingModeCBC" hashAlgorithm="SHA512" saltValue="G59MLop9A/a14ciUCj1+Yg=="/>` +
+		`<keyEncryptors><keyEncryptor uri="http://schemas.microsoft.com/office/2006/keyEncryptor/password">` +
+		`<p:encryptedKey spinCount="100000" saltSize="16" blockSize="16" keyBits="256" hashSize="64" cipherAlgorithm="AES" cipherChaining="ChainingModeCBC" hashAlgorithm="SHA512" saltValue="5AdbmjHG0vignkt8FdNvgA==" ` +
+		`encryptedVerifierHashInput="jqXeYm1bKm337A1VCGMLpw==" ` +
+		`encryptedVerifierHashValue="rOI7Vvupus8JZc51u5d5NvjUun9AQmkLSOGF7cCCDUj073KhfNl3+nMiphUnokdyu3npLRT6wzw0aT2lj/sL2A==" ` +
+		`encryptedKeyValue="0JqJQGGYoffjwgPr7CtoflwwpwRwddPe3kKYAwc3V+o="/>` +
+		`</keyEncryptor></keyEncryptors></encryption>`
+	knownAgilePackage = "RwAAAAAAAAAQDGdAOLPxV8DuHyu3iz6zcF4IvV96rfhaAeyNEmdK7VFfqYeOR+5UCfMsLmihoVsDrEQw+hpEWsos/IZNSAXIcGa29PuB" +
+		"NE6OCZtTs71R9g=="
+)
+
+func TestKnownAnswer(t *testing.T) {
+	standardInfo, _ := base64.StdEncoding.DecodeString(knownStandardInfo)
+	standardPackage, _ := base64.StdEncoding.DecodeString(knownStandardPackage)
+	agileInfo := append([]byte{4, 0, 4, 0, 0x40, 0, 0, 0}, knownAgileXML...)
+	agilePackage, _ := base64.StdEncoding.DecodeString(knownAgilePackage)
+
+	tests := []struct {
+		info, pkg []byte
+		key       string
+	}{
+		{standardInfo, standardPackage, knownStandardKey},
+		{agileInfo, agilePackage, knownAgileKey},
+	}
+	for testId, test := range tests {
+		info, err := ParseInfo(test.info)
+		if err != nil {
+			t.Errorf("Test [%d]: ParseInfo unexpected error [%v]", testId, err)
+			continue
+		}
+
+		var key []byte
+		if info.Standard != nil {
+			key, err = standardKey(info.Standard, knownPassword)
+		} else {
+			key, err = agileKey(info.Agile, knownPassword)
+		}
+		if err != nil || hex.EncodeToString(key) != test.key {
+			t.Errorf("Test [%d]: Expected key [%s], got [%x] error [%v]", testId, test.key, key, err)
+		}
+
+		r, err := NewReader(info, knownPassword, bytes.NewReader(test.pkg), int64(len(test.pkg)))
+		if err != nil {
+			t.Errorf("Test [%d]: NewReader unexpected error [%v]", testId, err)
+			continue
+		}
+
+		got, err := io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+		if err != nil || string(got) != knownContent {
+			t.Errorf("Test [%d]: Expected [%s], got [%s] error [%v]", testId, knownContent, got, err)
+		}
+	}
+}
+
+func TestParseInfo(t *testing.T) {
+	tests := []struct {
+		b   []byte
+		err error
+	}{
+		{[]byte{4, 0, 4, 0}, ErrInfo},
+		{[]byte{1, 0, 1, 0, 0, 0, 0, 0}, ErrVersion},
+		{[]byte{4, 0, 4, 0, 0x40, 0, 0, 0, '<'}, ErrInfo},
+		{[]byte{3, 0, 2, 0, 0x24, 0, 0, 0, 0xFF, 0, 0, 0}, ErrInfo},
+	}
+
+	for testId, test := range tests {
+		if _, err := ParseInfo(test.b); err != test.err {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package oleps
+
+import (
+	"errors"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// Property types (VARENUM)
+const (
+	VT_EMPTY            uint16 = 0x0000
+	VT_NULL                    = 0x0001
+	VT_I2                      = 0x0002
+	VT_I4                      = 0x0003
+	VT_R4                      = 0x0004
+	VT_R8                      = 0x0005
+	VT_CY                      = 0x0006
+	VT_DATE                    = 0x0007
+	VT_BSTR                    = 0x0008
+	VT_ERROR                   = 0x000A
+	VT_BOOL                    = 0x000B
+	VT_VARIANT                 = 0x000C // Only in vectors and arrays
+	VT_DECIMAL                 = 0x000E
+	VT_I1                      = 0x0010
+	VT_UI1                     = 0x0011
+	VT_UI2                     = 0x0012
+	VT_UI4                     = 0x0013
+	VT_I8                      = 0x0014
+	VT_UI8                     = 0x0015
+	VT_INT                     = 0x0016
+	VT_UINT                    = 0x0017
+	VT_LPSTR                   = 0x001E
+	VT_LPWSTR                  = 0x001F
+	VT_FILETIME                = 0x0040
+	VT_BLOB                    = 0x0041
+	VT_STREAM                  = 0x0042
+	VT_STORAGE                 = 0x0043
+	VT_STREAMED_OBJECT         = 0x0044
+	VT_STORED_OBJECT           = 0x0045
+	VT_BLOB_OBJECT             = 0x0046
+	VT_CF                      = 0x0047
+	VT_CLSID                   = 0x0048
+	VT_VERSIONED_STREAM        = 0x0049
+	VT_VECTOR                  = 0x1000
+	VT_ARRAY                   = 0x2000
+)
+
+// Special property identifiers
+const (
+	PID_DICTIONARY uint32 = 0x00000000
+	PID_CODEPAGE          = 0x00000001
+	PID_LOCALE            = 0x80000000
+	PID_BEHAVIOR          = 0x80000003
+)
+
+// SummaryInformation property identifiers
+const (
+	PIDSI_TITLE        uint32 = 0x00000002 // VT_LPSTR
+	PIDSI_SUBJECT             = 0x00000003 // VT_LPSTR
+	PIDSI_AUTHOR              = 0x00000004 // VT_LPSTR
+	PIDSI_KEYWORDS            = 0x00000005 // VT_LPSTR
+	PIDSI_COMMENTS            = 0x00000006 // VT_LPSTR
+	PIDSI_TEMPLATE            = 0x00000007 // VT_LPSTR
+	PIDSI_LASTAUTHOR          = 0x00000008 // VT_LPSTR
+	PIDSI_REVNUMBER           = 0x00000009 // VT_LPSTR
+	PIDSI_EDITTIME            = 0x0000000A // VT_FILETIME, a duration
+	PIDSI_LASTPRINTED         = 0x0000000B // VT_FILETIME
+	PIDSI_CREATE_DTM          = 0x0000000C // VT_FILETIME
+	PIDSI_LASTSAVE_DTM        = 0x0000000D // VT_FILETIME
+	PIDSI_PAGECOUNT           = 0x0000000E // VT_I4
+	PIDSI_WORDCOUNT           = 0x0000000F // VT_I4
+	PIDSI_CHARCOUNT           = 0x00000010 // VT_I4
+	PIDSI_THUMBNAIL           = 0x00000011 // VT_CF
+	PIDSI_APPNAME             = 0x00000012 // VT_LPSTR
+	PIDSI_DOC_SECURITY        = 0x00000013 // VT_I4
+)
+
+// DocumentSummaryInformation property identifiers
+const (
+	PIDDSI_CATEGORY          uint32 = 0x00000002 // VT_LPSTR
+	PIDDSI_PRESFORMAT               = 0x00000003 // VT_LPSTR
+	PIDDSI_BYTECOUNT                = 0x00000004 // VT_I4
+	PIDDSI_LINECOUNT                = 0x00000005 // VT_I4
+	PIDDSI_PARCOUNT                 = 0x00000006 // VT_I4
+	PIDDSI_SLIDECOUNT               = 0x00000007 // VT_I4
+	PIDDSI_NOTECOUNT                = 0x00000008 // VT_I4
+	PIDDSI_HIDDENCOUNT              = 0x00000009 // VT_I4
+	PIDDSI_MMCLIPCOUNT              = 0x0000000A // VT_I4
+	PIDDSI_SCALE                    = 0x0000000B // VT_BOOL
+	PIDDSI_HEADINGPAIR              = 0x0000000C // VT_VECTOR | VT_VARIANT
+	PIDDSI_DOCPARTS                 = 0x0000000D // VT_VECTOR | VT_LPSTR
+	PIDDSI_MANAGER                  = 0x0000000E // VT_LPSTR
+	PIDDSI_COMPANY                  = 0x0000000F // VT_LPSTR
+	PIDDSI_LINKSDIRTY               = 0x00000010 // VT_BOOL
+	PIDDSI_CCHWITHSPACES            = 0x00000011 // VT_I4
+	PIDDSI_SHAREDDOC                = 0x00000013 // VT_BOOL
+	PIDDSI_HYPERLINKSCHANGED        = 0x00000016 // VT_BOOL
+	PIDDSI_VERSION                  = 0x00000017 // VT_I4
+	PIDDSI_CONTENTSTATUS            = 0x0000001B // VT_LPSTR
+)
+
+// Code pages with special handling
+const (
+	CP_WINUNICODE uint16 = 1200  // UTF-16, little endian
+	CP_UTF8              = 65001 // UTF-8
+)
+
+// Stream names of the well known property sets
+const (
+	SummaryInformationName         = "\x05SummaryInformation"
+	DocumentSummaryInformationName = "\x05DocumentSummaryInformation"
+)
+
+var (
+	FMTID_SummaryInformation    = cfb.GUID{0xF29F85E0, 0x4FF9, 0x1068, [8]byte{0xAB, 0x91, 0x08, 0x00, 0x2B, 0x27, 0xB3, 0xD9}}
+	FMTID_DocSummaryInformation = cfb.GUID{0xD5CDD502, 0x2E9C, 0x101B, [8]byte{0x93, 0x97, 0x08, 0x00, 0x2B, 0x2C, 0xF9, 0xAE}}
+	FMTID_UserDefinedProperties = cfb.GUID{0xD5CDD505, 0x2E9C, 0x101B, [8]byte{0x93, 0x97, 0x08, 0x00, 0x2B, 0x2C, 0xF9, 0xAE}}
+)
+
+var (
+	ErrByteOrder    = errors.New("Bad property set byte order")
+	ErrTruncated    = errors.New("Property set stream is truncated")
+	ErrPropertySets = errors.New("Bad number of property sets")
+	ErrPropertyType = errors.New("Unsupported property type")
+	ErrNotFound     = errors.New("Property set not found")
+)
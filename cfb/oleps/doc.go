@@ -0,0 +1,4 @@
+// package oleps decode OLE property set streams, eg. "\x05SummaryInformation" and "\x05DocumentSummaryInformation",
+// as found in compound files (.doc, .xls, .ppt, .msg etc).
+// This package rely on the specification [MS-OLEPS]: Object Linking and Embedding (OLE) Property Set Data Structures.
+package oleps
@@ -0,0 +1,275 @@
+package oleps
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+var byteOrder = binary.LittleEndian
+
+// PropertySetStream is a decoded property set stream, holding one or two property sets.
+type PropertySetStream struct {
+	ByteOrder        uint16
+	Version          uint16
+	SystemIdentifier uint32
+	CLSID            cfb.GUID
+	Sets             []*PropertySet
+}
+
+// PropertySet is a set of properties identified by FMTID.
+type PropertySet struct {
+	FMTID      cfb.GUID
+	CodePage   uint16
+	Dictionary map[uint32]string // Property names, if the set has a dictionary
+	Properties []*Property
+}
+
+// Property is a single typed property value.
+// Value is nil for VT_EMPTY, VT_NULL and types not supported by this package.
+type Property struct {
+	ID    uint32
+	Type  uint16
+	Value interface{}
+}
+
+// Decode decode a property set stream.
+func Decode(b []byte) (s *PropertySetStream, err error) {
+	if len(b) < 28 {
+		return nil, ErrTruncated
+	}
+
+	s = new(PropertySetStream)
+	s.ByteOrder = byteOrder.Uint16(b[0:])
+	s.Version = byteOrder.Uint16(b[2:])
+	s.SystemIdentifier = byteOrder.Uint32(b[4:])
+	s.CLSID = readGUID(b[8:])
+	if s.ByteOrder != 0xFFFE {
+		return nil, ErrByteOrder
+	}
+
+	count := byteOrder.Uint32(b[24:])
+	if count == 0 || uint64(len(b)) < 28+uint64(count)*20 {
+		return nil, ErrPropertySets
+	}
+
+	for i := uint32(0); i < count; i++ {
+		header := b[28+i*20:]
+		offset := byteOrder.Uint32(header[16:])
+		if uint64(offset) >= uint64(len(b)) {
+			return nil, ErrTruncated
+		}
+
+		set, err := decodeSet(b[offset:])
+		if err != nil {
+			return nil, err
+		}
+		set.FMTID = readGUID(header)
+		s.Sets = append(s.Sets, set)
+	}
+	return
+}
+
+// Read read and decode a property set stream.
+func Read(r io.Reader) (s *PropertySetStream, err error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	return Decode(b)
+}
+
+// Open decode the property set stream of a directory entry.
+func Open(entry *cfb.DirectoryEntry) (s *PropertySetStream, err error) {
+	stream, err := entry.Stream()
+	if err != nil {
+		return
+	}
+	return Read(stream)
+}
+
+// SummaryInformation decode the "\x05SummaryInformation" stream of the document.
+func SummaryInformation(d *cfb.Document) (s *PropertySetStream, err error) {
+	return lookup(d, SummaryInformationName)
+}
+
+// DocumentSummaryInformation decode the "\x05DocumentSummaryInformation" stream of the document.
+// The second property set, if any, hold the user defined properties.
+func DocumentSummaryInformation(d *cfb.Document) (s *PropertySetStream, err error) {
+	return lookup(d, DocumentSummaryInformationName)
+}
+
+func lookup(d *cfb.Document, name string) (s *PropertySetStream, err error) {
+	entry, err := d.Lookup(name)
+	if err != nil {
+		return
+	}
+	return Open(entry)
+}
+
+// Set return the property set with the given FMTID, or nil if not found.
+func (s *PropertySetStream) Set(fmtid cfb.GUID) *PropertySet {
+	for _, set := range s.Sets {
+		if set.FMTID == fmtid {
+			return set
+		}
+	}
+	return nil
+}
+
+func decodeSet(b []byte) (set *PropertySet, err error) {
+	if len(b) < 8 {
+		return nil, ErrTruncated
+	}
+
+	size := byteOrder.Uint32(b[0:])
+	count := byteOrder.Uint32(b[4:])
+	if uint64(size) > uint64(len(b)) || uint64(size) < 8+uint64(count)*8 {
+		return nil, ErrTruncated
+	}
+	b = b[:size]
+
+	set = new(PropertySet)
+	offsets := make(map[uint32]uint32, count)
+	for i := uint32(0); i < count; i++ {
+		id := byteOrder.Uint32(b[8+i*8:])
+		offsets[id] = byteOrder.Uint32(b[12+i*8:])
+	}
+
+	// The code page must be known before strings, including the dictionary, can be decoded.
+	if offset, ok := offsets[PID_CODEPAGE]; ok {
+		if uint64(offset)+8 > uint64(len(b)) {
+			return nil, ErrTruncated
+		}
+		set.CodePage = byteOrder.Uint16(b[offset+4:])
+	}
+
+	for i := uint32(0); i < count; i++ {
+		id := byteOrder.Uint32(b[8+i*8:])
+		offset := byteOrder.Uint32(b[12+i*8:])
+		if uint64(offset) >= uint64(len(b)) {
+			return nil, ErrTruncated
+		}
+
+		if id == PID_DICTIONARY {
+			if set.Dictionary, err = decodeDictionary(b[offset:], set.CodePage); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		p := &Property{ID: id}
+		d := decoder{b: b[offset:], codePage: set.CodePage}
+		p.Type = d.uint16()
+		d.skip(2)
+		p.Value = d.typed(p.Type)
+		if d.err != nil {
+			return nil, d.err
+		}
+		set.Properties = append(set.Properties, p)
+	}
+	return
+}
+
+func decodeDictionary(b []byte, codePage uint16) (dict map[uint32]string, err error) {
+	d := decoder{b: b, codePage: codePage}
+	count := d.uint32()
+	dict = make(map[uint32]string)
+	for i := uint32(0); i < count && d.err == nil; i++ {
+		id := d.uint32()
+		length := d.uint32()
+		if codePage == CP_WINUNICODE {
+			dict[id] = d.unicode(length * 2)
+			d.align()
+		} else {
+			dict[id] = d.string(length)
+		}
+	}
+	return dict, d.err
+}
+
+// Property return the property with the given ID, or nil if not found.
+func (s *PropertySet) Property(id uint32) *Property {
+	for _, p := range s.Properties {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// Named return the property named in the dictionary, or nil if not found. Names are case sensitive.
+func (s *PropertySet) Named(name string) *Property {
+	for id, n := range s.Dictionary {
+		if n == name {
+			return s.Property(id)
+		}
+	}
+	return nil
+}
+
+// Value return the value of the property with the given ID, nil if not found.
+func (s *PropertySet) Value(id uint32) interface{} {
+	if p := s.Property(id); p != nil {
+		return p.Value
+	}
+	return nil
+}
+
+// String return the string value of the property, "" if not found or not a string.
+func (s *PropertySet) String(id uint32) (v string) {
+	v, _ = s.Value(id).(string)
+	return
+}
+
+// Int return the integer value of the property, 0 if not found or not an integer.
+func (s *PropertySet) Int(id uint32) int64 {
+	switch v := s.Value(id).(type) {
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	}
+	return 0
+}
+
+// Time return the time value of a VT_FILETIME or VT_DATE property, the zero time if not found.
+func (s *PropertySet) Time(id uint32) time.Time {
+	switch v := s.Value(id).(type) {
+	case cfb.FILETIME:
+		return v.Time()
+	case time.Time:
+		return v
+	}
+	return time.Time{}
+}
+
+// Duration return the value of a VT_FILETIME property holding a duration, eg. PIDSI_EDITTIME.
+func (s *PropertySet) Duration(id uint32) time.Duration {
+	if v, ok := s.Value(id).(cfb.FILETIME); ok {
+		return time.Duration(v.Ticks()) * 100
+	}
+	return 0
+}
+
+func readGUID(b []byte) (g cfb.GUID) {
+	g.DataA = byteOrder.Uint32(b[0:])
+	g.DataB = byteOrder.Uint16(b[4:])
+	g.DataC = byteOrder.Uint16(b[6:])
+	copy(g.DataD[:], b[8:16])
+	return
+}
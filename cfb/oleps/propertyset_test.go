@@ -0,0 +1,224 @@
+package oleps
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+type testProperty struct {
+	id    uint32
+	value []byte // Type, padding and value
+}
+
+func typed(vt uint16, values ...interface{}) []byte {
+	var b bytes.Buffer
+	binary.Write(&b, byteOrder, vt)
+	binary.Write(&b, byteOrder, uint16(0))
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			b.WriteString(v)
+		default:
+			binary.Write(&b, byteOrder, v)
+		}
+	}
+	for b.Len()%4 != 0 {
+		b.WriteByte(0)
+	}
+	return b.Bytes()
+}
+
+func testSet(properties []testProperty) []byte {
+	var values bytes.Buffer
+	header := 8 + 8*len(properties)
+	offsets := make([]uint32, len(properties))
+	for i, p := range properties {
+		offsets[i] = uint32(header + values.Len())
+		values.Write(p.value)
+	}
+
+	var b bytes.Buffer
+	binary.Write(&b, byteOrder, uint32(header+values.Len()))
+	binary.Write(&b, byteOrder, uint32(len(properties)))
+	for i, p := range properties {
+		binary.Write(&b, byteOrder, p.id)
+		binary.Write(&b, byteOrder, offsets[i])
+	}
+	b.Write(values.Bytes())
+	return b.Bytes()
+}
+
+func testStream(fmtids []cfb.GUID, sets ...[]byte) []byte {
+	var b bytes.Buffer
+	binary.Write(&b, byteOrder, uint16(0xFFFE))
+	binary.Write(&b, byteOrder, uint16(0))
+	binary.Write(&b, byteOrder, uint32(0x00020006))
+	binary.Write(&b, byteOrder, cfb.CLSID_NULL)
+	binary.Write(&b, byteOrder, uint32(len(sets)))
+
+	offset := 28 + 20*len(sets)
+	for i, set := range sets {
+		binary.Write(&b, byteOrder, fmtids[i])
+		binary.Write(&b, byteOrder, uint32(offset))
+		offset += len(set)
+	}
+	for _, set := range sets {
+		b.Write(set)
+	}
+	return b.Bytes()
+}
+
+func utf16z(s string) []uint16 {
+	return append(utf16.Encode([]rune(s)), 0)
+}
+
+func TestDecode(t *testing.T) {
+	created := time.Date(2020, 5, 17, 10, 30, 0, 0, time.UTC)
+	summary := testSet([]testProperty{
+		{PID_CODEPAGE, typed(VT_I2, int16(1252))},
+		{PIDSI_TITLE, typed(VT_LPSTR, uint32(6), "Caf\xe9\x00\x00")},
+		{PIDSI_AUTHOR, typed(VT_LPSTR, uint32(5), "Anne\x00")},
+		{PIDSI_EDITTIME, typed(VT_FILETIME, uint64(90*10000000))},
+		{PIDSI_CREATE_DTM, typed(VT_FILETIME, cfb.NewFILETIME(created))},
+		{PIDSI_PAGECOUNT, typed(VT_I4, int32(42))},
+		{PIDSI_THUMBNAIL, typed(VT_CF, uint32(6), int32(-1), []byte{1, 2})},
+	})
+
+	dictionary := typed(0, uint32(1), uint32(2), uint32(7), utf16z("Client"))[4:]
+	user := testSet([]testProperty{
+		{PID_CODEPAGE, typed(VT_I2, int16(1200))},
+		{PID_DICTIONARY, dictionary},
+		{2, typed(VT_LPSTR, uint32(10), utf16z("ACME"))},
+		{3, typed(VT_VECTOR|VT_VARIANT, uint32(2), typed(VT_LPWSTR, uint32(3), utf16z("ab")), typed(VT_BOOL, uint16(0xFFFF)))},
+		{4, typed(VT_VECTOR|VT_I2, uint32(3), []int16{1, 2, 3})},
+		{5, typed(VT_DATE, float64(43968.4375))},
+	})
+
+	s, err := Decode(testStream([]cfb.GUID{FMTID_SummaryInformation, FMTID_UserDefinedProperties}, summary, user))
+	if err != nil {
+		t.Fatalf("Decode: unexpected error [%v]", err)
+	}
+	if len(s.Sets) != 2 {
+		t.Fatalf("Expected [2] property sets, got [%d]", len(s.Sets))
+	}
+
+	si := s.Set(FMTID_SummaryInformation)
+	if si == nil {
+		t.Fatalf("SummaryInformation property set not found")
+	}
+
+	stringTests := []struct {
+		set    *PropertySet
+		id     uint32
+		expect string
+	}{
+		{si, PIDSI_TITLE, "Café"},
+		{si, PIDSI_AUTHOR, "Anne"},
+		{si, PIDSI_SUBJECT, ""},
+		{s.Sets[1], 2, "ACME"},
+	}
+	for testId, test := range stringTests {
+		if got := test.set.String(test.id); got != test.expect {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.expect, got)
+		}
+	}
+
+	if got := si.Int(PIDSI_PAGECOUNT); got != 42 {
+		t.Errorf("Expected page count [42], got [%d]", got)
+	}
+	if got := si.Time(PIDSI_CREATE_DTM); !got.Equal(created) {
+		t.Errorf("Expected created [%v], got [%v]", created, got)
+	}
+	if got := si.Duration(PIDSI_EDITTIME); got != 90*time.Second {
+		t.Errorf("Expected edit time [%v], got [%v]", 90*time.Second, got)
+	}
+	if got, _ := si.Value(PIDSI_THUMBNAIL).([]byte); !bytes.Equal(got, []byte{0xFF, 0xFF, 0xFF, 0xFF, 1, 2}) {
+		t.Errorf("Expected thumbnail data, got [%v]", got)
+	}
+
+	ud := s.Sets[1]
+	if ud.CodePage != CP_WINUNICODE || ud.Dictionary[2] != "Client" {
+		t.Errorf("Expected dictionary with [Client], got code page [%d] and [%v]", ud.CodePage, ud.Dictionary)
+	}
+	if p := ud.Named("Client"); p == nil || p.Value != "ACME" {
+		t.Errorf("Expected named property [Client], got [%v]", p)
+	}
+
+	variants, _ := ud.Value(3).([]interface{})
+	if len(variants) != 2 || variants[0] != "ab" || variants[1] != true {
+		t.Errorf("Expected variant vector [ab true], got [%v]", ud.Value(3))
+	}
+
+	shorts, _ := ud.Value(4).([]interface{})
+	if len(shorts) != 3 || shorts[0] != int16(1) || shorts[2] != int16(3) {
+		t.Errorf("Expected vector [1 2 3], got [%v]", ud.Value(4))
+	}
+
+	if expect, got := time.Date(2020, 5, 17, 10, 30, 0, 0, time.UTC), ud.Time(5); !got.Equal(expect) {
+		t.Errorf("Expected date [%v], got [%v]", expect, got)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	valid := testStream([]cfb.GUID{FMTID_SummaryInformation}, testSet([]testProperty{
+		{PIDSI_TITLE, typed(VT_LPSTR, uint32(4), "abc\x00")},
+	}))
+
+	badOrder := append([]byte(nil), valid...)
+	badOrder[0] = 0xFF
+
+	noSets := append([]byte(nil), valid...)
+	noSets[24] = 0
+
+	tests := []struct {
+		b      []byte
+		expect error
+	}{
+		{valid, nil},
+		{valid[:20], ErrTruncated},
+		{badOrder, ErrByteOrder},
+		{noSets, ErrPropertySets},
+		{valid[:len(valid)-2], ErrTruncated},
+	}
+
+	for testId, test := range tests {
+		if _, err := Decode(test.b); err != test.expect {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.expect, err)
+		}
+	}
+}
+
+func TestSummaryInformation(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(SummaryInformationName, testStream([]cfb.GUID{FMTID_SummaryInformation}, testSet([]testProperty{
+		{PIDSI_TITLE, typed(VT_LPSTR, uint32(6), "Title\x00")},
+	})))
+
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	r, err := cfb.Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+
+	s, err := SummaryInformation(r)
+	if err != nil {
+		t.Fatalf("SummaryInformation: unexpected error [%v]", err)
+	}
+	if got := s.Set(FMTID_SummaryInformation).String(PIDSI_TITLE); got != "Title" {
+		t.Errorf("Expected [Title], got [%v]", got)
+	}
+
+	if _, err = DocumentSummaryInformation(r); err != cfb.ErrNotFound {
+		t.Errorf("Expected error [%v], got [%v]", cfb.ErrNotFound, err)
+	}
+}
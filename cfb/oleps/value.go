@@ -0,0 +1,198 @@
+package oleps
+
+import (
+	"bytes"
+	"math"
+	"time"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+	"github.com/xianhammer/format/internal/codepage"
+)
+
+// OLE automation dates count days from December 30, 1899.
+var oleEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// decoder read typed values, the first error stop further reading.
+type decoder struct {
+	b        []byte
+	offset   int
+	codePage uint16
+	err      error
+}
+
+func (d *decoder) next(n uint32) (b []byte) {
+	if d.err != nil {
+		return nil
+	}
+	if uint64(n) > uint64(len(d.b)-d.offset) {
+		d.err = ErrTruncated
+		return nil
+	}
+	b = d.b[d.offset : d.offset+int(n)]
+	d.offset += int(n)
+	return
+}
+
+func (d *decoder) skip(n uint32) {
+	d.next(n)
+}
+
+// align skip padding up to a multiple of 4 bytes.
+func (d *decoder) align() {
+	if pad := d.offset % 4; pad != 0 && d.offset+4-pad <= len(d.b) {
+		d.offset += 4 - pad
+	}
+}
+
+func (d *decoder) uint8() uint8 {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uint16() uint16 {
+	if b := d.next(2); b != nil {
+		return byteOrder.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) uint32() uint32 {
+	if b := d.next(4); b != nil {
+		return byteOrder.Uint32(b)
+	}
+	return 0
+}
+
+func (d *decoder) uint64() uint64 {
+	if b := d.next(8); b != nil {
+		return byteOrder.Uint64(b)
+	}
+	return 0
+}
+
+func (d *decoder) bytes() []byte {
+	b := d.next(d.uint32())
+	d.align()
+	return append([]byte(nil), b...)
+}
+
+// string read n bytes of a null terminated string in the code page of the decoder.
+func (d *decoder) string(n uint32) string {
+	if d.codePage == CP_WINUNICODE {
+		return d.unicode(n)
+	}
+	b := d.next(n)
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return codepage.Decode(b, d.codePage)
+}
+
+// unicode read n bytes of a null terminated UTF-16 string.
+func (d *decoder) unicode(n uint32) string {
+	b := d.next(n)
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		c := byteOrder.Uint16(b[i:])
+		if c == 0 {
+			break
+		}
+		u = append(u, c)
+	}
+	return string(utf16.Decode(u))
+}
+
+// typed read a value of the given type. Values of unsupported types are returned as nil.
+func (d *decoder) typed(vt uint16) (v interface{}) {
+	if vt&VT_VECTOR != 0 {
+		return d.vector(vt &^ VT_VECTOR)
+	}
+
+	v = d.scalar(vt)
+	d.align()
+	return
+}
+
+// scalar read a value, without trailing padding as values are packed within vectors.
+func (d *decoder) scalar(vt uint16) (v interface{}) {
+	switch vt {
+	case VT_EMPTY, VT_NULL:
+		return nil
+	case VT_I1:
+		return int8(d.uint8())
+	case VT_UI1:
+		return d.uint8()
+	case VT_I2:
+		return int16(d.uint16())
+	case VT_UI2:
+		return d.uint16()
+	case VT_I4, VT_INT:
+		return int32(d.uint32())
+	case VT_UI4, VT_UINT, VT_ERROR:
+		return d.uint32()
+	case VT_I8, VT_CY:
+		return int64(d.uint64())
+	case VT_UI8:
+		return d.uint64()
+	case VT_R4:
+		return math.Float32frombits(d.uint32())
+	case VT_R8:
+		return math.Float64frombits(d.uint64())
+	case VT_BOOL:
+		return d.uint16() != 0
+	case VT_DATE:
+		days := math.Float64frombits(d.uint64())
+		return oleEpoch.Add(time.Duration(days * float64(24*time.Hour)))
+	case VT_FILETIME:
+		var f cfb.FILETIME
+		f.LowDateTime = d.uint32()
+		f.HighDateTime = d.uint32()
+		return f
+	case VT_CLSID:
+		if b := d.next(16); b != nil {
+			return readGUID(b)
+		}
+		return nil
+	case VT_LPSTR, VT_BSTR, VT_STREAM, VT_STORAGE, VT_STREAMED_OBJECT, VT_STORED_OBJECT:
+		// Stream and storage types hold the name of the stream or storage.
+		return d.string(d.uint32())
+	case VT_LPWSTR:
+		return d.unicode(d.uint32() * 2)
+	case VT_BLOB, VT_BLOB_OBJECT, VT_CF:
+		return d.bytes()
+	case VT_VARIANT:
+		t := d.uint16()
+		d.skip(2)
+		return d.typed(t)
+	}
+	return nil
+}
+
+func (d *decoder) vector(vt uint16) (v interface{}) {
+	count := d.uint32()
+	values := make([]interface{}, 0)
+	for i := uint32(0); i < count && d.err == nil; i++ {
+		value := d.scalar(vt)
+		if value == nil && vt != VT_VARIANT {
+			// Unknown element type, the rest of the vector cannot be read.
+			return nil
+		}
+		if isString(vt) {
+			d.align()
+		}
+		values = append(values, value)
+	}
+	d.align()
+	return values
+}
+
+func isString(vt uint16) bool {
+	switch vt {
+	case VT_LPSTR, VT_BSTR, VT_LPWSTR:
+		return true
+	}
+	return false
+}
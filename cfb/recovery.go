@@ -0,0 +1,162 @@
+package cfb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// Damage describe the loss of stream content in recovery mode.
+type Damage struct {
+	Err       error  // Cause of the loss, eg. ErrSectorID or ErrChainCycle
+	Size      uint32 // Stream size according to the directory entry
+	Recovered uint32 // Number of bytes recovered
+}
+
+// OpenRecovery open a, possibly damaged, document for lazy reading in recovery mode. See Open and SetRecovery.
+func OpenRecovery(r io.ReaderAt, size int64) (d *Document, err error) {
+	d = new(Document)
+	d.recovery = true
+	err = d.open(r, size)
+	return
+}
+
+// SetRecovery enable recovery mode for a document about to be read with ReadFrom.
+// In recovery mode damaged structures are tolerated as far as possible: sector chains are cut at invalid sector IDs and cycles,
+// directory sectors are searched for if the directory chain is broken, invalid directory entries are skipped
+// and streams are partially recovered. Structural losses are reported by Losses, lost stream content by DirectoryEntry.Damage.
+func (d *Document) SetRecovery(enabled bool) {
+	d.recovery = enabled
+}
+
+// Losses return the structures lost in recovery mode, eg. unreadable FAT sectors or skipped directory entries.
+func (d *Document) Losses() []Finding {
+	return d.losses
+}
+
+func (d *Document) lose(err error, sector, entry uint32) {
+	d.losses = append(d.losses, Finding{err, sector, entry, false})
+}
+
+// recoverDirectory return a directory stream, extending a broken directory chain with the following directory sectors,
+// or searching for the directory if the chain is unusable.
+func (d *Document) recoverDirectory(s *Stream, chainErr error) (*Stream, error) {
+	if chainErr == nil && s.Sectors() > 0 && d.isRootSector(s.ids[0]) {
+		return s, nil
+	}
+
+	var sIDs []uint32
+	if s != nil && s.Sectors() > 0 && d.isRootSector(s.ids[0]) {
+		sIDs = s.ids
+	} else {
+		for sID := uint32(0); sID < d.sectorCount(); sID++ {
+			if d.isRootSector(sID) {
+				sIDs = []uint32{sID}
+				break
+			}
+		}
+	}
+
+	if chainErr == nil { // The chain is intact, but does not start with the root entry
+		chainErr = ErrStorageType
+	}
+
+	if len(sIDs) == 0 {
+		return nil, chainErr
+	}
+	d.lose(chainErr, d.SectDirStart, NOSTREAM)
+
+	// Directory sectors are usually allocated contiguously, continue with the sectors following the last known.
+	inChain := make(map[uint32]bool)
+	for _, sID := range sIDs {
+		inChain[sID] = true
+	}
+	for sID := sIDs[len(sIDs)-1] + 1; sID < d.sectorCount() && !inChain[sID] && d.isDirectorySector(sID); sID++ {
+		sIDs = append(sIDs, sID)
+	}
+
+	s = NewStream(d.sectorSize, 0)
+	s.doc = d
+	for _, sID := range sIDs {
+		s.addID(sID, true)
+	}
+	return s, nil
+}
+
+// recoverStreams register the damage of all streams.
+func (d *Document) recoverStreams() {
+	d.root.Walk(func(de *DirectoryEntry) {
+		if de.Type != STGTY_STREAM || de.Size == 0 {
+			return
+		}
+
+		s, err := d.stream(de.Start, de.Size)
+		if err != nil {
+			de.damage = &Damage{Err: err, Size: de.Size}
+			if s != nil {
+				de.damage.Recovered = s.Len()
+			}
+		}
+	})
+}
+
+func (d *Document) isRootSector(sID uint32) bool {
+	s, err := d.sector(sID)
+	if err != nil {
+		return false
+	}
+
+	var dir Directory
+	binary.Read(bytes.NewReader(s), d.byteOrder, &dir)
+	return dir.Type == STGTY_ROOT && isDirectory(&dir) && d.isDirectorySector(sID)
+}
+
+// isDirectorySector return true if every directory entry of the sector is either empty or plausible.
+func (d *Document) isDirectorySector(sID uint32) bool {
+	s, err := d.sector(sID)
+	if err != nil {
+		return false
+	}
+
+	used := 0
+	for offset := 0; offset+DirectorySize <= len(s); offset += DirectorySize {
+		var dir Directory
+		binary.Read(bytes.NewReader(s[offset:]), d.byteOrder, &dir)
+		if dir.Type == STGTY_INVALID && dir.NameLength == 0 {
+			continue
+		}
+
+		if !isDirectory(&dir) {
+			return false
+		}
+		used++
+	}
+	return used > 0
+}
+
+// validName report whether the name is not empty, the name length is even and within the name field and the name is
+// terminated.
+func validName(dir *Directory) bool {
+	return dir.NameLength > 2 && dir.NameLength <= 64 && dir.NameLength%2 == 0 && dir.NameUTF16[dir.NameLength/2-1] == 0
+}
+
+func isDirectory(dir *Directory) bool {
+	if dir.Type != STGTY_STORAGE && dir.Type != STGTY_STREAM && dir.Type != STGTY_ROOT {
+		return false
+	}
+
+	if !validName(dir) {
+		return false
+	}
+
+	if dir.Flags > DE_BLACK {
+		return false
+	}
+
+	for _, id := range []uint32{dir.LeftSibling, dir.RightSibling, dir.Child} {
+		if id != NOSTREAM && id > MAXREGSID {
+			return false
+		}
+	}
+	return true
+}
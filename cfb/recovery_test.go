@@ -0,0 +1,187 @@
+package cfb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// recoveryTestFile return a serialized document with a multi-sector directory and a large stream, its parsed form
+// and a function patching the FAT entry of a sector in the serialized form.
+func recoveryTestFile(t *testing.T) (b []byte, d *Document, patch func(b []byte, sID, value uint32)) {
+	w, _ := New()
+	root, _ := w.Root()
+	for i := 0; i < 10; i++ {
+		root.AddStream(fmt.Sprintf("Stream%d", i), testContent(100, byte(i)))
+	}
+	root.AddStream("Large", testContent(10000, 1))
+
+	var buf bytes.Buffer
+	if _, err := w.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+	b = buf.Bytes()
+
+	d, _ = New()
+	if _, err := d.ReadFrom(bytes.NewReader(b)); err != nil {
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	patch = func(b []byte, sID, value uint32) {
+		offset := (d.Fat[0]+1)*d.sectorSize + 4*sID
+		binary.LittleEndian.PutUint32(b[offset:], value)
+	}
+	return
+}
+
+func findEntry(d *Document, name string) (entry *DirectoryEntry) {
+	d.Walk(func(de *DirectoryEntry) {
+		if de.Name() == name {
+			entry = de
+		}
+	})
+	return
+}
+
+func TestRecoveryStreamChain(t *testing.T) {
+	b, d, patch := recoveryTestFile(t)
+	large := findEntry(d, "Large")
+	patch(b, large.Start+5, large.Start+1)
+
+	o, err := Open(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	if _, err = findEntry(o, "Large").Stream(); err != ErrChainCycle {
+		t.Errorf("Expected error [%v], got [%v]", ErrChainCycle, err)
+	}
+
+	r, err := OpenRecovery(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("OpenRecovery: unexpected error [%v]", err)
+	}
+
+	large = findEntry(r, "Large")
+	damage := large.Damage()
+	if damage == nil {
+		t.Fatalf("Expected damage")
+	}
+	if damage.Err != ErrChainCycle || damage.Size != 10000 || damage.Recovered != 6*512 {
+		t.Errorf("Unexpected damage %+v", damage)
+	}
+
+	s, err := large.Stream()
+	if err != nil {
+		t.Fatalf("Stream: unexpected error [%v]", err)
+	}
+
+	var got bytes.Buffer
+	io.Copy(&got, s)
+	if !bytes.Equal(got.Bytes(), testContent(10000, 1)[:6*512]) {
+		t.Errorf("Expected %d recovered bytes, got %d", 6*512, got.Len())
+	}
+
+	if findEntry(r, "Stream3").Damage() != nil {
+		t.Errorf("Unexpected damage of intact stream")
+	}
+}
+
+func TestRecoveryMiniStreamChain(t *testing.T) {
+	b, d, _ := recoveryTestFile(t)
+	small := findEntry(d, "Stream0")
+	offset := (d.MiniFatStart+1)*d.sectorSize + 4*small.Start
+	binary.LittleEndian.PutUint32(b[offset:], small.Start) // Mini sector chain to itself
+
+	r, err := OpenRecovery(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("OpenRecovery: unexpected error [%v]", err)
+	}
+
+	damage := findEntry(r, "Stream0").Damage()
+	if damage == nil {
+		t.Fatalf("Expected damage")
+	}
+	if damage.Err != ErrChainCycle || damage.Recovered != 64 {
+		t.Errorf("Unexpected damage %+v", damage)
+	}
+}
+
+func TestRecoveryDirectory(t *testing.T) {
+	b, d, patch := recoveryTestFile(t)
+	patch(b, d.SectDirStart, FREESECT)
+
+	if _, err := Open(bytes.NewReader(b), int64(len(b))); err == nil {
+		t.Errorf("Expected error opening a broken directory without recovery")
+	}
+
+	r, err := OpenRecovery(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("OpenRecovery: unexpected error [%v]", err)
+	}
+
+	root, _ := r.Root()
+	if len(root.Children()) != 11 {
+		t.Errorf("Expected 11 children, got %d", len(root.Children()))
+	}
+	if len(r.Losses()) == 0 {
+		t.Errorf("Expected losses to be reported")
+	}
+}
+
+func TestRecoveryDirectoryStart(t *testing.T) {
+	b, _, _ := recoveryTestFile(t)
+	binary.LittleEndian.PutUint32(b[48:], 0x7FFFFFFF) // SectDirStart
+
+	r, err := OpenRecovery(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("OpenRecovery: unexpected error [%v]", err)
+	}
+
+	root, _ := r.Root()
+	if len(root.Children()) != 11 {
+		t.Errorf("Expected 11 children, got %d", len(root.Children()))
+	}
+}
+
+func TestRecoverySectorID(t *testing.T) {
+	b, d, patch := recoveryTestFile(t)
+	large := findEntry(d, "Large")
+	patch(b, large.Start+3, 100000)
+
+	r, _ := New()
+	r.SetRecovery(true)
+	if _, err := r.ReadFrom(bytes.NewReader(b)); err != nil {
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	damage := findEntry(r, "Large").Damage()
+	if damage == nil {
+		t.Fatalf("Expected damage")
+	}
+	if damage.Err != ErrSectorID || damage.Recovered != 4*512 {
+		t.Errorf("Unexpected damage %+v", damage)
+	}
+}
+
+func TestRecoveryTruncated(t *testing.T) {
+	b, _, _ := recoveryTestFile(t)
+	b = b[:len(b)-100]
+
+	d, _ := New()
+	if _, err := d.ReadFrom(bytes.NewReader(b)); err != ErrSectorSize {
+		t.Errorf("Expected error [%v], got [%v]", ErrSectorSize, err)
+	}
+
+	r, _ := New()
+	r.SetRecovery(true)
+	if _, err := r.ReadFrom(bytes.NewReader(b)); err != nil {
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	losses := r.Losses()
+	if len(losses) != 1 || losses[0].Err != ErrSectorSize {
+		t.Errorf("Expected a single [%v] loss, got %v", ErrSectorSize, losses)
+	}
+}
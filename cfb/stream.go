@@ -16,7 +16,9 @@ var (
 
 type Stream struct {
 	s          []Sector
-	offset     uint32
+	ids        []uint32  // Document sectors, fetched on demand
+	doc        *Document // Owner of the sectors in ids
+	offset     int64
 	size       uint32
 	sectorSize uint32
 	byteorder  binary.ByteOrder
@@ -30,6 +32,14 @@ func NewStream(sectorSize, streamSize uint32) (s *Stream) {
 	}
 }
 
+func newBufferStream(data []byte) (s *Stream) {
+	s = NewStream(uint32(len(data)), uint32(len(data)))
+	if len(data) > 0 {
+		s.add(Sector(data), false)
+	}
+	return
+}
+
 func (s *Stream) add(sect Sector, addSize bool) {
 	s.s = append(s.s, sect)
 	if addSize {
@@ -37,57 +47,107 @@ func (s *Stream) add(sect Sector, addSize bool) {
 	}
 }
 
+// addID add a document sector, the sector data is fetched when read.
+func (s *Stream) addID(sID uint32, addSize bool) {
+	s.ids = append(s.ids, sID)
+	if addSize {
+		s.size += s.sectorSize
+	}
+}
+
 func (s *Stream) Sectors() uint32 {
-	return uint32(len(s.s))
+	return uint32(len(s.s) + len(s.ids))
 }
 
 func (s *Stream) Len() uint32 {
 	if s.size > 0 {
 		return s.size
 	}
-	return uint32(len(s.s)) * s.sectorSize
+	return s.Sectors() * s.sectorSize
 }
 
+func (s *Stream) sector(index uint32) (sect Sector, err error) {
+	if s.ids == nil {
+		return s.s[index], nil
+	}
+	return s.doc.sector(s.ids[index])
+}
+
+// Size return the stream length, eg. for use with io.NewSectionReader or zip.NewReader.
+func (s *Stream) Size() int64 {
+	return int64(s.Len())
+}
+
+// Seek implement io.Seeker. Seeking beyond the end of the stream is allowed, a following Read return io.EOF.
 func (s *Stream) Seek(offset int64, whence int) (n int64, err error) {
-	size := s.Len()
-	pos := s.offset
 	switch whence {
 	case io.SeekStart:
-		pos = uint32(offset)
 	case io.SeekCurrent:
-		pos += uint32(offset)
+		offset += s.offset
 	case io.SeekEnd:
-		pos = size - uint32(offset)
+		offset += s.Size()
+	default:
+		return s.offset, ErrSeekIndex
 	}
 
-	if 0 <= pos && pos < size {
-		s.offset = pos
-		n = int64(pos)
-	} else {
-		err = ErrSeekIndex
+	if offset < 0 {
+		return s.offset, ErrSeekIndex
 	}
 
-	return
+	s.offset = offset
+	return offset, nil
 }
 
 func (s *Stream) Read(dst []byte) (n int, err error) {
-	if s.offset >= s.size {
+	if s.offset >= s.Size() {
 		return 0, io.EOF
 	}
 
-	sID := s.offset / s.sectorSize
-	if 0 < s.size && s.size <= s.offset {
-		return 0, io.EOF
+	n, err = s.ReadAt(dst, s.offset)
+	s.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
 	}
+	return
+}
 
-	src := s.s[sID][s.offset%s.sectorSize:]
-	if (sID+1) == uint32(len(s.s)) && s.size-s.offset < s.sectorSize {
-		src = src[:s.size-s.offset]
+// ReadAt implement io.ReaderAt. ReadAt does not use or change the offset used by Read and Seek,
+// so concurrent ReadAt calls are safe.
+func (s *Stream) ReadAt(dst []byte, offset int64) (n int, err error) {
+	if offset < 0 {
+		return 0, ErrSeekIndex
 	}
 
-	n = copy(dst, src)
+	size := s.Size()
+	sectorSize := int64(s.sectorSize)
+	for n < len(dst) && offset < size {
+		index := offset / sectorSize
+		if index >= int64(s.Sectors()) {
+			break
+		}
+
+		var sect Sector
+		if sect, err = s.sector(uint32(index)); err != nil {
+			return
+		}
+
+		start := offset - index*sectorSize
+		end := int64(len(sect))
+		if rest := size - index*sectorSize; rest < end {
+			end = rest
+		}
+		if start >= end {
+			break
+		}
+
+		copied := copy(dst[n:], sect[start:end])
+		n += copied
+		offset += int64(copied)
+	}
 
-	s.offset += uint32(n)
+	if n < len(dst) {
+		err = io.EOF
+	}
 	return
 }
 
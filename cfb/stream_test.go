@@ -0,0 +1,185 @@
+package cfb
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+)
+
+func streamTestDocument(t *testing.T, content map[string][]byte) *Document {
+	w, _ := New()
+	root, _ := w.Root()
+	for name, b := range content {
+		root.AddStream(name, b)
+	}
+
+	var b bytes.Buffer
+	if _, err := w.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	d, err := Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	return d
+}
+
+func TestStreamSeek(t *testing.T) {
+	content := testContent(10000, 5)
+	d := streamTestDocument(t, map[string][]byte{"Stream": content})
+	entry, _ := d.Lookup("Stream")
+	s, _ := entry.Stream()
+
+	tests := []struct {
+		offset int64
+		whence int
+		pos    int64
+		err    error
+	}{
+		{100, io.SeekStart, 100, nil},
+		{50, io.SeekCurrent, 150, nil},
+		{-10, io.SeekEnd, 9990, nil},
+		{0, io.SeekEnd, 10000, nil},
+		{5, io.SeekEnd, 10005, nil},
+		{-1, io.SeekStart, 10005, ErrSeekIndex},
+		{513, io.SeekStart, 513, nil},
+	}
+
+	for testId, test := range tests {
+		pos, err := s.Seek(test.offset, test.whence)
+		if pos != test.pos || err != test.err {
+			t.Errorf("Test [%d]: Expected (%d, %v), got (%d, %v)", testId, test.pos, test.err, pos, err)
+		}
+	}
+
+	b := make([]byte, 1000)
+	if n, err := io.ReadFull(s, b); err != nil || !bytes.Equal(b[:n], content[513:1513]) {
+		t.Errorf("Read after seek: unexpected content, error [%v]", err)
+	}
+
+	s.Seek(0, io.SeekEnd)
+	if n, err := s.Read(b); n != 0 || err != io.EOF {
+		t.Errorf("Read at end: expected (0, EOF), got (%d, %v)", n, err)
+	}
+}
+
+func TestStreamReadAt(t *testing.T) {
+	content := map[string][]byte{
+		"Mini":  testContent(3000, 1),
+		"Large": testContent(70000, 2),
+	}
+	d := streamTestDocument(t, content)
+	d.SetCache(16)
+
+	for name, expect := range content {
+		entry, _ := d.Lookup(name)
+		s, _ := entry.Stream()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				offset := int64(i * len(expect) / 8)
+				b := make([]byte, len(expect)/4)
+				n, err := s.ReadAt(b, offset)
+				if int(offset)+len(b) > len(expect) {
+					if err != io.EOF || n != len(expect)-int(offset) {
+						t.Errorf("Stream [%s]: ReadAt(%d) expected EOF after %d bytes, got (%d, %v)", name, offset, len(expect)-int(offset), n, err)
+					}
+				} else if err != nil {
+					t.Errorf("Stream [%s]: ReadAt(%d) unexpected error [%v]", name, offset, err)
+				}
+
+				if !bytes.Equal(b[:n], expect[offset:int(offset)+n]) {
+					t.Errorf("Stream [%s]: ReadAt(%d) content mismatch", name, offset)
+				}
+			}(i)
+		}
+		wg.Wait()
+	}
+}
+
+func TestStreamZip(t *testing.T) {
+	var zb bytes.Buffer
+	zw := zip.NewWriter(&zb)
+	for _, name := range []string{"a.txt", "b.txt"} {
+		w, _ := zw.Create(name)
+		w.Write(testContent(20000, name[0]))
+	}
+	zw.Close()
+
+	d := streamTestDocument(t, map[string][]byte{"Package": zb.Bytes()})
+	entry, _ := d.Lookup("Package")
+	s, _ := entry.Stream()
+
+	zr, err := zip.NewReader(s, s.Size())
+	if err != nil {
+		t.Fatalf("zip.NewReader: unexpected error [%v]", err)
+	}
+
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatalf("Open [%s]: unexpected error [%v]", f.Name, err)
+		}
+
+		b, err := io.ReadAll(r)
+		if err != nil || !bytes.Equal(b, testContent(20000, f.Name[0])) {
+			t.Errorf("File [%s]: content mismatch, error [%v]", f.Name, err)
+		}
+		r.Close()
+	}
+}
+
+func TestConcurrentRead(t *testing.T) {
+	w, _ := New()
+	root, _ := w.Root()
+	storage, _ := root.AddStorage("Storage")
+	content := make(map[string][]byte)
+	for i := 0; i < 16; i++ {
+		name := "Stream" + string(rune('A'+i))
+		content[name] = testContent(1000+i*3000, byte(i))
+		storage.AddStream(name, content[name])
+	}
+
+	var b bytes.Buffer
+	if _, err := w.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	opened, _ := Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	opened.SetCache(8)
+	read, _ := New()
+	if _, err := read.ReadFrom(bytes.NewReader(b.Bytes())); err != nil { // The directory is read by the first Root
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	for testId, d := range []*Document{opened, read} {
+		var wg sync.WaitGroup
+		for name, expect := range content {
+			wg.Add(1)
+			go func(name string, expect []byte) {
+				defer wg.Done()
+
+				entry, err := d.Lookup("Storage/" + name)
+				if err != nil {
+					t.Errorf("Test [%d]: Lookup [%s] unexpected error [%v]", testId, name, err)
+					return
+				}
+
+				s, _ := entry.Stream()
+				got, err := io.ReadAll(s)
+				if err != nil || !bytes.Equal(got, expect) {
+					t.Errorf("Test [%d]: stream [%s] expected [%d] bytes, got [%d] bytes, error [%v]", testId, name, len(expect), len(got), err)
+				}
+				d.Walk(func(*DirectoryEntry) {})
+			}(name, expect)
+		}
+		wg.Wait()
+	}
+}
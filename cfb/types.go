@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"time"
 )
 
 type GUID struct {
@@ -22,6 +23,35 @@ type FILETIME struct {
 	HighDateTime uint32 // Windows FILETIME structure
 }
 
+// Seconds from the FILETIME epoch (January 1, 1601 UTC) to the Unix epoch.
+const filetimeUnixOffset = 11644473600
+
+// NewFILETIME convert a time to FILETIME, the zero time give a zero FILETIME.
+func NewFILETIME(t time.Time) (f FILETIME) {
+	if t.IsZero() {
+		return
+	}
+
+	ticks := uint64(t.Unix()+filetimeUnixOffset)*10000000 + uint64(t.Nanosecond()/100)
+	f.LowDateTime = uint32(ticks)
+	f.HighDateTime = uint32(ticks >> 32)
+	return
+}
+
+// Ticks return the number of 100-nanosecond intervals since January 1, 1601 UTC.
+func (f FILETIME) Ticks() uint64 {
+	return uint64(f.HighDateTime)<<32 | uint64(f.LowDateTime)
+}
+
+// Time convert the FILETIME to UTC time, a zero FILETIME give the zero time.
+func (f FILETIME) Time() time.Time {
+	ticks := f.Ticks()
+	if ticks == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(ticks/10000000)-filetimeUnixOffset, int64(ticks%10000000)*100).UTC()
+}
+
 type Sector []byte
 
 func (s Sector) Reader() (r io.Reader) {
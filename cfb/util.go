@@ -3,6 +3,7 @@ package cfb
 import (
 	"io"
 	"sort"
+	"unicode"
 	"unicode/utf16"
 )
 
@@ -21,3 +22,25 @@ func SortDirectories(dir []*DirectoryEntry) {
 		return dir[i].Name() < dir[j].Name()
 	})
 }
+
+// CompareNames compare directory entry names in the order used by the red-black trees of a CFB.
+// Shorter names are less than longer names, equal length names are compared by upper cased UTF-16 values.
+func CompareNames(a, b string) int {
+	ua, ub := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	if len(ua) != len(ub) {
+		if len(ua) < len(ub) {
+			return -1
+		}
+		return 1
+	}
+
+	for i := range ua {
+		ca, cb := unicode.ToUpper(rune(ua[i])), unicode.ToUpper(rune(ub[i]))
+		if ca < cb {
+			return -1
+		} else if ca > cb {
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,384 @@
+package cfb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// Finding is a single structural problem found by Document.Validate.
+type Finding struct {
+	Err     error  // The problem, one of the Err* values of this package
+	Sector  uint32 // Sector, or mini sector, concerned. FREESECT if none
+	Entry   uint32 // Directory entry concerned, NOSTREAM if none
+	Warning bool   // The problem does not prevent data from being read, eg. an unbalanced directory tree
+}
+
+func (f Finding) String() string {
+	s := f.Err.Error()
+	if f.Sector != FREESECT {
+		s += fmt.Sprintf(", sector %d", f.Sector)
+	}
+	if f.Entry != NOSTREAM {
+		s += fmt.Sprintf(", entry %d", f.Entry)
+	}
+	return s
+}
+
+// Report is the result of Document.Validate.
+type Report struct {
+	Findings []Finding
+	Sectors  uint32 // Number of sectors following the header
+	Free     uint32 // Number of sectors marked free in the FAT
+	Orphans  uint32 // Number of allocated sectors not used by any chain
+}
+
+// Valid return true if nothing was found.
+func (r *Report) Valid() bool {
+	return len(r.Findings) == 0
+}
+
+// Damaged return true if any finding, except warnings, was found.
+func (r *Report) Damaged() bool {
+	for _, f := range r.Findings {
+		if !f.Warning {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) add(err error, sector, entry uint32, warning bool) {
+	r.Findings = append(r.Findings, Finding{err, sector, entry, warning})
+}
+
+// Validate check the structure of the document: header fields, FAT and mini FAT chains (range, cycles, sharing and orphans),
+// stream sizes and the directory tree (range, cycles, ordering and red-black properties).
+// Problems are returned in the report, the error is only set if sectors cannot be read.
+func (d *Document) Validate() (r *Report, err error) {
+	v := &validator{doc: d, report: new(Report)}
+	err = v.run()
+	return v.report, err
+}
+
+type validator struct {
+	doc       *Document
+	report    *Report
+	owner     []int // Chain number per sector, 0 when unused
+	miniOwner []int
+	minifat   []uint32
+	chains    int
+	entries   []Directory
+	visited   []bool
+}
+
+func (v *validator) run() (err error) {
+	d := v.doc
+	v.header()
+
+	v.report.Sectors = d.sectorCount()
+	v.owner = make([]int, len(d.fat))
+	if err = v.fatSectors(); err != nil {
+		return
+	}
+
+	dirSectors := v.chain(d.fat, v.owner, d.SectDirStart, NOSTREAM)
+	if d.MajorVersion == 4 && d.SectDir != uint32(len(dirSectors)) {
+		v.report.add(ErrDirectoryCount, FREESECT, NOSTREAM, true)
+	}
+	if err = v.readEntries(dirSectors); err != nil {
+		return
+	}
+
+	miniFatSectors := v.chain(d.fat, v.owner, d.MiniFatStart, NOSTREAM)
+	for _, sID := range miniFatSectors {
+		values := make([]uint32, d.sectorSize/4)
+		if err = d.readBinary(sID, values); err != nil {
+			return
+		}
+		v.minifat = append(v.minifat, values...)
+	}
+
+	if len(v.entries) > 0 {
+		root := &v.entries[0]
+		ministream := v.chain(d.fat, v.owner, root.Start, 0)
+		if uint32(len(ministream))*d.sectorSize < root.Size {
+			v.report.add(ErrStreamSize, FREESECT, 0, false)
+		}
+
+		if count := root.Size / d.miniSectorSize; uint32(len(v.minifat)) > count {
+			v.minifat = v.minifat[:count]
+		}
+		v.miniOwner = make([]int, len(v.minifat))
+
+		v.tree(0)
+	}
+
+	for sID, value := range d.fat {
+		if value == FREESECT {
+			v.report.Free++
+		} else if v.owner[sID] == 0 {
+			v.report.Orphans++
+			v.report.add(ErrOrphanSector, uint32(sID), NOSTREAM, true)
+		}
+	}
+
+	return
+}
+
+func (v *validator) header() {
+	d := v.doc
+	r := v.report
+
+	if d.Signature != Signature {
+		r.add(ErrSignature, FREESECT, NOSTREAM, false)
+	}
+
+	if d.CLSID != CLSID_NULL {
+		r.add(ErrCLSID, FREESECT, NOSTREAM, true)
+	}
+
+	if d.MajorVersion != 3 && d.MajorVersion != 4 {
+		r.add(ErrVersion, FREESECT, NOSTREAM, false)
+	} else if d.MinorVersion != 0x003E {
+		r.add(ErrVersion, FREESECT, NOSTREAM, true)
+	}
+
+	if d.ByteOrder != 0xFFFE {
+		r.add(ErrByteOrder, FREESECT, NOSTREAM, false)
+	}
+
+	if (d.MajorVersion == 3 && d.SectorShift != 9) || (d.MajorVersion == 4 && d.SectorShift != 12) {
+		r.add(ErrSectorShift, FREESECT, NOSTREAM, false)
+	}
+
+	if d.MajorVersion == 3 && d.SectDir != 0 {
+		r.add(ErrDirectoryCount, FREESECT, NOSTREAM, true)
+	}
+
+	if d.MiniSectorShift != 6 {
+		r.add(ErrMiniSectorShift, FREESECT, NOSTREAM, false)
+	}
+
+	if d.MiniSectorCutoff != 0x1000 {
+		r.add(ErrMiniSectorCutoff, FREESECT, NOSTREAM, false)
+	}
+}
+
+// fatSectors check that the sectors listed in the header and DIFAT are marked as FAT and DIFAT sectors.
+func (v *validator) fatSectors() (err error) {
+	d := v.doc
+	fat := d.fat
+
+	var count uint32
+	for _, sID := range d.Fat[:] {
+		if sID == FREESECT {
+			continue
+		}
+		count++
+		v.fatSector(sID)
+	}
+
+	difat := make([]uint32, d.sectorSize/4)
+	last := len(difat) - 1
+	sID := d.DifStart
+	for n := uint32(0); n < d.Dif && sID != ENDOFCHAIN; n++ {
+		if sID >= uint32(len(fat)) {
+			v.report.add(ErrSectorID, sID, NOSTREAM, false)
+			break
+		}
+
+		if fat[sID] != DIFSECT {
+			v.report.add(ErrDIFATSector, sID, NOSTREAM, false)
+		}
+		if v.owner[sID] != 0 {
+			v.report.add(ErrChainCycle, sID, NOSTREAM, false)
+			break
+		}
+		v.owner[sID] = -1
+
+		if err = d.readBinary(sID, difat); err != nil {
+			return
+		}
+
+		for _, fID := range difat[:last] {
+			if fID != FREESECT {
+				count++
+				v.fatSector(fID)
+			}
+		}
+		sID = difat[last]
+	}
+
+	if count != d.SectFAT {
+		v.report.add(ErrFATCount, FREESECT, NOSTREAM, false)
+	}
+	return
+}
+
+func (v *validator) fatSector(sID uint32) {
+	if sID >= uint32(len(v.doc.fat)) {
+		v.report.add(ErrSectorID, sID, NOSTREAM, false)
+		return
+	}
+
+	if v.doc.fat[sID] != FATSECT {
+		v.report.add(ErrFATSector, sID, NOSTREAM, false)
+	}
+	v.owner[sID] = -1
+}
+
+// chain follow a sector chain, marking the sectors as used. The chain is cut at the first problem found.
+func (v *validator) chain(fat []uint32, owner []int, start, entry uint32) (sectors []uint32) {
+	v.chains++
+	for sID := start; sID != ENDOFCHAIN; sID = fat[sID] {
+		if sID >= uint32(len(fat)) {
+			v.report.add(ErrSectorID, sID, entry, false)
+			return
+		}
+
+		if owner[sID] == v.chains {
+			v.report.add(ErrChainCycle, sID, entry, false)
+			return
+		}
+
+		if owner[sID] != 0 {
+			v.report.add(ErrChainShared, sID, entry, false)
+			return
+		}
+
+		owner[sID] = v.chains
+		sectors = append(sectors, sID)
+	}
+	return
+}
+
+func (v *validator) readEntries(sectors []uint32) (err error) {
+	d := v.doc
+	for _, sID := range sectors {
+		var s Sector
+		if s, err = d.sector(sID); err != nil {
+			return
+		}
+
+		for offset := 0; offset+DirectorySize <= len(s); offset += DirectorySize {
+			var dir Directory
+			binary.Read(bytes.NewReader(s[offset:]), d.byteOrder, &dir)
+			v.entries = append(v.entries, dir)
+		}
+	}
+
+	if len(v.entries) == 0 {
+		v.report.add(ErrDirectoryID, FREESECT, 0, false)
+	} else if v.entries[0].Type != STGTY_ROOT {
+		v.report.add(ErrStorageType, FREESECT, 0, false)
+	}
+
+	v.visited = make([]bool, len(v.entries))
+	return
+}
+
+// tree check the entry and, for storages, the tree of its children.
+func (v *validator) tree(id uint32) {
+	v.visited[id] = true
+	dir := &v.entries[id]
+	err := dir.Validate()
+	if err == nil && !validName(dir) {
+		err = ErrNameLength
+	}
+	if err != nil {
+		v.report.add(err, FREESECT, id, false)
+	}
+
+	if id == 0 && (dir.LeftSibling != NOSTREAM || dir.RightSibling != NOSTREAM) {
+		v.report.add(ErrRootSibling, FREESECT, id, false)
+	}
+
+	switch dir.Type {
+	case STGTY_STREAM:
+		v.streamSize(id)
+	case STGTY_STORAGE, STGTY_ROOT:
+		if dir.Child != NOSTREAM {
+			if v.enter(dir.Child, id) {
+				if v.entries[dir.Child].Flags != DE_BLACK {
+					v.report.add(ErrRedBlack, FREESECT, dir.Child, true)
+				}
+				v.siblings(dir.Child, false, "", "")
+			}
+		}
+	}
+}
+
+// enter check that id can be visited, returning false for out of range IDs and cycles.
+func (v *validator) enter(id, from uint32) bool {
+	if id >= uint32(len(v.entries)) {
+		v.report.add(ErrDirectoryID, FREESECT, from, false)
+		return false
+	}
+
+	if v.visited[id] {
+		v.report.add(ErrDirectoryCycle, FREESECT, from, false)
+		return false
+	}
+	return true
+}
+
+// siblings check the sibling tree rooted at id, the names must be within (lower, upper). Return the black height.
+func (v *validator) siblings(id uint32, parentRed bool, lower, upper string) (height int) {
+	v.tree(id)
+	dir := &v.entries[id]
+
+	name := dir.rawName()
+	if (lower != "" && CompareNames(lower, name) >= 0) || (upper != "" && CompareNames(name, upper) >= 0) {
+		v.report.add(ErrDirectoryOrder, FREESECT, id, true)
+	}
+
+	red := dir.Flags == DE_RED
+	if dir.Flags > DE_BLACK || (red && parentRed) {
+		v.report.add(ErrRedBlack, FREESECT, id, true)
+	}
+
+	left, right := 0, 0
+	if dir.LeftSibling != NOSTREAM && v.enter(dir.LeftSibling, id) {
+		left = v.siblings(dir.LeftSibling, red, lower, name)
+	}
+	if dir.RightSibling != NOSTREAM && v.enter(dir.RightSibling, id) {
+		right = v.siblings(dir.RightSibling, red, name, upper)
+	}
+
+	if left != right {
+		v.report.add(ErrRedBlack, FREESECT, id, true)
+	}
+
+	height = left
+	if right > height {
+		height = right
+	}
+	if !red {
+		height++
+	}
+	return
+}
+
+// streamSize check that the sector chain of a stream match the stream size.
+func (v *validator) streamSize(id uint32) {
+	d := v.doc
+	dir := &v.entries[id]
+	if dir.Size == 0 {
+		return
+	}
+
+	var sectors []uint32
+	sectorSize := d.sectorSize
+	if dir.Size < d.MiniSectorCutoff {
+		sectors = v.chain(v.minifat, v.miniOwner, dir.Start, id)
+		sectorSize = d.miniSectorSize
+	} else {
+		sectors = v.chain(d.fat, v.owner, dir.Start, id)
+	}
+
+	count := uint32(len(sectors))
+	if count*sectorSize < dir.Size || (count-1)*sectorSize >= dir.Size {
+		v.report.add(ErrStreamSize, FREESECT, id, false)
+	}
+}
@@ -0,0 +1,94 @@
+package cfb
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func validateTestDocument(t *testing.T) (d *Document, large *DirectoryEntry) {
+	w, _ := New()
+	root, _ := w.Root()
+	storage, _ := root.AddStorage("Storage")
+	for i := 0; i < 20; i++ {
+		storage.AddStream(fmt.Sprintf("Stream%d", i), testContent(100*i, byte(i)))
+	}
+	root.AddStream("Large", testContent(5000, 1))
+
+	var b bytes.Buffer
+	if _, err := w.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	d, _ = New()
+	if _, err := d.ReadFrom(&b); err != nil {
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	d.Walk(func(de *DirectoryEntry) {
+		if de.Name() == "Large" {
+			large = de
+		}
+	})
+	return
+}
+
+func TestValidate(t *testing.T) {
+	d, _ := validateTestDocument(t)
+
+	r, err := d.Validate()
+	if err != nil {
+		t.Fatalf("Validate: unexpected error [%v]", err)
+	}
+	if !r.Valid() {
+		t.Errorf("Expected valid document, got findings %v", r.Findings)
+	}
+	if r.Sectors == 0 || r.Orphans != 0 {
+		t.Errorf("Unexpected sector statistics, sectors [%d], orphans [%d]", r.Sectors, r.Orphans)
+	}
+}
+
+func TestValidateDamaged(t *testing.T) {
+	tests := []struct {
+		damage func(d *Document, large *DirectoryEntry)
+		expect error
+	}{
+		{func(d *Document, large *DirectoryEntry) { d.fat[large.Start+2] = large.Start }, ErrChainCycle},
+		{func(d *Document, large *DirectoryEntry) { d.fat[large.Start+2] = 100000 }, ErrSectorID},
+		{func(d *Document, large *DirectoryEntry) { d.fat[large.Start] = ENDOFCHAIN }, ErrOrphanSector},
+		{func(d *Document, large *DirectoryEntry) { d.fat[large.Start] = ENDOFCHAIN }, ErrStreamSize},
+		{func(d *Document, large *DirectoryEntry) { d.fat[large.Start+9] = d.SectDirStart }, ErrChainShared},
+		{func(d *Document, large *DirectoryEntry) { d.SectFAT++ }, ErrFATCount},
+		{func(d *Document, large *DirectoryEntry) { d.Signature[0] = 0 }, ErrSignature},
+	}
+
+	for testId, test := range tests {
+		d, large := validateTestDocument(t)
+		test.damage(d, large)
+
+		r, err := d.Validate()
+		if err != nil {
+			t.Fatalf("Test [%d]: Validate unexpected error [%v]", testId, err)
+		}
+
+		found := false
+		for _, f := range r.Findings {
+			found = found || f.Err == test.expect
+		}
+		if !found {
+			t.Errorf("Test [%d]: Expected finding [%v], got %v", testId, test.expect, r.Findings)
+		}
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	for testId, length := range []byte{2, 3} {
+		r, err := damagedNameDocument(t, length).Validate()
+		if err != nil {
+			t.Fatalf("Test [%d]: Validate unexpected error [%v]", testId, err)
+		}
+		if len(r.Findings) != 1 || r.Findings[0].Err != ErrNameLength || !r.Damaged() {
+			t.Errorf("Test [%d]: Expected finding [%v], got %v", testId, ErrNameLength, r.Findings)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package vba
+
+import "errors"
+
+// dir stream record identifiers
+const (
+	PROJECTSYSKIND          uint16 = 0x0001
+	PROJECTLCID                    = 0x0002
+	PROJECTCODEPAGE                = 0x0003
+	PROJECTNAME                    = 0x0004
+	PROJECTDOCSTRING               = 0x0005
+	PROJECTHELPFILEPATH            = 0x0006
+	PROJECTHELPCONTEXT             = 0x0007
+	PROJECTLIBFLAGS                = 0x0008
+	PROJECTVERSION                 = 0x0009 // Size is 4, but followed by 6 bytes
+	PROJECTCONSTANTS               = 0x000C
+	REFERENCEREGISTERED            = 0x000D
+	REFERENCEPROJECT               = 0x000E
+	PROJECTMODULES                 = 0x000F
+	DIRTERMINATOR                  = 0x0010
+	PROJECTCOOKIE                  = 0x0013
+	PROJECTLCIDINVOKE              = 0x0014
+	REFERENCENAME                  = 0x0016
+	MODULENAME                     = 0x0019
+	MODULESTREAMNAME               = 0x001A
+	MODULEDOCSTRING                = 0x001C
+	MODULEHELPCONTEXT              = 0x001E
+	MODULETYPEPROCEDURAL           = 0x0021
+	MODULETYPEDOCUMENT             = 0x0022
+	MODULEREADONLY                 = 0x0025
+	MODULEPRIVATE                  = 0x0028
+	MODULETERMINATOR               = 0x002B
+	MODULECOOKIE                   = 0x002C
+	REFERENCECONTROL               = 0x002F
+	MODULEOFFSET                   = 0x0031
+	MODULESTREAMNAMEUNICODE        = 0x0032
+	REFERENCEORIGINAL              = 0x0033
+	PROJECTCONSTANTSUNICODE        = 0x003C
+	PROJECTHELPFILEPATH2           = 0x003D
+	REFERENCENAMEUNICODE           = 0x003E
+	PROJECTDOCSTRINGUNICODE        = 0x0040
+	MODULENAMEUNICODE              = 0x0047
+	MODULEDOCSTRINGUNICODE         = 0x0048
+	PROJECTCOMPATVERSION           = 0x004A
+)
+
+var (
+	ErrSignature = errors.New("Bad compressed container signature")
+	ErrChunk     = errors.New("Bad compressed chunk")
+	ErrRecord    = errors.New("Bad dir stream record")
+	ErrNotFound  = errors.New("VBA project not found")
+	ErrOffset    = errors.New("Module source offset out of range")
+)
@@ -0,0 +1,82 @@
+package vba
+
+import "encoding/binary"
+
+// Decompress decompress a compressed container, as used by the dir stream and module source code.
+func Decompress(b []byte) (out []byte, err error) {
+	if len(b) == 0 || b[0] != 0x01 {
+		return nil, ErrSignature
+	}
+
+	for pos := 1; pos < len(b); {
+		if pos+2 > len(b) {
+			return nil, ErrChunk
+		}
+
+		header := binary.LittleEndian.Uint16(b[pos:])
+		size := int(header&0x0FFF) + 3
+		if header&0x7000 != 0x3000 {
+			return nil, ErrChunk
+		}
+
+		end := pos + size
+		if end > len(b) {
+			end = len(b) // The last chunk may be truncated
+		}
+
+		if header&0x8000 == 0 {
+			out = append(out, b[pos+2:end]...)
+		} else if out, err = decompressChunk(out, b[pos+2:end]); err != nil {
+			return
+		}
+		pos = end
+	}
+	return
+}
+
+func decompressChunk(out, b []byte) ([]byte, error) {
+	start := len(out)
+	for pos := 0; pos < len(b); {
+		flags := b[pos]
+		pos++
+
+		for bit := 0; bit < 8 && pos < len(b); bit++ {
+			if flags&(1<<bit) == 0 {
+				out = append(out, b[pos])
+				pos++
+				continue
+			}
+
+			if pos+2 > len(b) {
+				return out, ErrChunk
+			}
+			token := binary.LittleEndian.Uint16(b[pos:])
+			pos += 2
+
+			offset, length := copyToken(token, len(out)-start)
+			if offset > len(out)-start {
+				return out, ErrChunk
+			}
+
+			// Copy byte by byte, source and destination may overlap.
+			for src := len(out) - offset; length > 0; length-- {
+				out = append(out, out[src])
+				src++
+			}
+		}
+	}
+	return out, nil
+}
+
+// copyToken unpack a copy token, the split between offset and length bits depend on the decompressed position in the chunk.
+func copyToken(token uint16, position int) (offset, length int) {
+	bitCount := 4
+	for 1<<bitCount < position {
+		bitCount++
+	}
+
+	lengthMask := uint16(0xFFFF) >> bitCount
+	offset = int(token>>(16-bitCount)) + 1
+	length = int(token&lengthMask) + 3
+	return
+}
@@ -0,0 +1,3 @@
+// package vba extract VBA macro projects, module names and source code, from compound files (.doc, .xls etc).
+// This package rely on the specification [MS-OVBA]: Office VBA File Format Structure.
+package vba
@@ -0,0 +1,200 @@
+package vba
+
+import (
+	"encoding/binary"
+	"io"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+	"github.com/xianhammer/format/internal/codepage"
+)
+
+// Project is a VBA project, ie. a "VBA" storage with a "dir" stream.
+type Project struct {
+	Name     string
+	CodePage uint16
+	Storage  *cfb.DirectoryEntry // The "VBA" storage
+	Modules  []*Module
+}
+
+// Module is a VBA module, a procedural module or a document, class or designer module.
+type Module struct {
+	Name       string
+	StreamName string // Name of the module stream in the VBA storage
+	Offset     uint32 // Offset of the compressed source code in the module stream
+	Procedural bool
+	ReadOnly   bool
+	Private    bool
+	Source     string
+}
+
+// Projects return the VBA projects of a document, eg. "Macros/VBA" in Word documents or "_VBA_PROJECT_CUR/VBA" in Excel workbooks.
+// A document without macros return no projects and no error.
+func Projects(d *cfb.Document) (projects []*Project, err error) {
+	for _, storage := range storages(d) {
+		var p *Project
+		if p, err = Open(storage); err != nil {
+			return
+		}
+		projects = append(projects, p)
+	}
+	return
+}
+
+// HasMacros return true if the document contain a VBA project.
+func HasMacros(d *cfb.Document) bool {
+	return len(storages(d)) > 0
+}
+
+// storages return the "VBA" storages having a "dir" stream.
+func storages(d *cfb.Document) (found []*cfb.DirectoryEntry) {
+	d.Walk(func(de *cfb.DirectoryEntry) {
+		if de.Type == cfb.STGTY_STREAM || cfb.CompareNames(de.Name(), "VBA") != 0 {
+			return
+		}
+
+		if dir, _ := de.Lookup("dir"); dir != nil && dir.Type == cfb.STGTY_STREAM {
+			found = append(found, de)
+		}
+	})
+	return
+}
+
+// Open read the project of a "VBA" storage, including the source code of all modules.
+func Open(storage *cfb.DirectoryEntry) (p *Project, err error) {
+	dir, err := storage.Lookup("dir")
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	b, err := readStream(dir)
+	if err != nil {
+		return
+	}
+
+	if b, err = Decompress(b); err != nil {
+		return
+	}
+
+	p = &Project{Storage: storage}
+	if err = p.parseDir(b); err != nil {
+		return nil, err
+	}
+
+	for _, m := range p.Modules {
+		if err = p.readSource(m); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// parseDir read the records of the decompressed dir stream.
+func (p *Project) parseDir(b []byte) (err error) {
+	var m *Module
+	for pos := 0; pos < len(b); {
+		if pos+6 > len(b) {
+			return ErrRecord
+		}
+
+		id := binary.LittleEndian.Uint16(b[pos:])
+		size := int(binary.LittleEndian.Uint32(b[pos+2:]))
+		if id == PROJECTVERSION {
+			size = 6
+		}
+
+		pos += 6
+		if size < 0 || size > len(b)-pos {
+			return ErrRecord
+		}
+		data := b[pos : pos+size]
+		pos += size
+
+		switch id {
+		case PROJECTCODEPAGE:
+			if len(data) >= 2 {
+				p.CodePage = binary.LittleEndian.Uint16(data)
+			}
+		case PROJECTNAME:
+			p.Name = codepage.Decode(data, p.CodePage)
+		case MODULENAME:
+			m = &Module{Name: codepage.Decode(data, p.CodePage)}
+			p.Modules = append(p.Modules, m)
+		case DIRTERMINATOR:
+			return
+		}
+
+		if m == nil {
+			continue
+		}
+
+		switch id {
+		case MODULENAMEUNICODE:
+			m.Name = utf16String(data)
+		case MODULESTREAMNAME:
+			m.StreamName = codepage.Decode(data, p.CodePage)
+		case MODULESTREAMNAMEUNICODE:
+			m.StreamName = utf16String(data)
+		case MODULEOFFSET:
+			if len(data) >= 4 {
+				m.Offset = binary.LittleEndian.Uint32(data)
+			}
+		case MODULETYPEPROCEDURAL:
+			m.Procedural = true
+		case MODULEREADONLY:
+			m.ReadOnly = true
+		case MODULEPRIVATE:
+			m.Private = true
+		case MODULETERMINATOR:
+			m = nil
+		}
+	}
+	return
+}
+
+func (p *Project) readSource(m *Module) (err error) {
+	stream, err := p.Storage.Lookup(m.StreamName)
+	if err != nil {
+		return
+	}
+
+	b, err := readStream(stream)
+	if err != nil {
+		return
+	}
+
+	if uint64(m.Offset) >= uint64(len(b)) {
+		return ErrOffset
+	}
+
+	if b, err = Decompress(b[m.Offset:]); err == nil {
+		m.Source = codepage.Decode(b, p.CodePage)
+	}
+	return
+}
+
+// Module return the module with the given name, or nil if not found. Names are compared case insensitive.
+func (p *Project) Module(name string) *Module {
+	for _, m := range p.Modules {
+		if cfb.CompareNames(m.Name, name) == 0 {
+			return m
+		}
+	}
+	return nil
+}
+
+func readStream(entry *cfb.DirectoryEntry) (b []byte, err error) {
+	s, err := entry.Stream()
+	if err != nil {
+		return
+	}
+	return io.ReadAll(s)
+}
+
+func utf16String(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(u))
+}
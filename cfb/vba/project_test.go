@@ -0,0 +1,176 @@
+package vba
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// compress create a compressed container using literal tokens only, chunks must be shorter than 3640 bytes.
+func compress(b []byte) []byte {
+	out := []byte{0x01}
+	for len(b) > 0 {
+		n := len(b)
+		if n > 3600 {
+			n = 3600
+		}
+
+		var chunk []byte
+		for i := 0; i < n; i += 8 {
+			end := i + 8
+			if end > n {
+				end = n
+			}
+			chunk = append(chunk, 0x00)
+			chunk = append(chunk, b[i:end]...)
+		}
+
+		out = append(out, uint16le(uint16(len(chunk)+2-3)|0xB000)...)
+		out = append(out, chunk...)
+		b = b[n:]
+	}
+	return out
+}
+
+func TestDecompress(t *testing.T) {
+	tests := []struct {
+		compressed []byte
+		expect     string
+		err        error
+	}{
+		{ // [MS-OVBA] 3.2.1, no compression
+			[]byte{0x01, 0x19, 0xB0, 0x00, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0x00, 0x69, 0x6A, 0x6B, 0x6C,
+				0x6D, 0x6E, 0x6F, 0x70, 0x00, 0x71, 0x72, 0x73, 0x74, 0x75, 0x76, 0x2E},
+			"abcdefghijklmnopqrstuv.", nil,
+		},
+		{ // Copy token at position 1, offset 1 and length 14
+			[]byte{0x01, 0x03, 0xB0, 0x02, 0x61, 0x0B, 0x00},
+			"aaaaaaaaaaaaaaa", nil,
+		},
+		{ // Copy token at position 26, 5 offset bits, offset 26 and length 10
+			[]byte("\x01\x1F\xB0\x00abcdefgh\x00ijklmnop\x00qrstuvwx\x04yz\x07\xC8"),
+			"abcdefghijklmnopqrstuvwxyzabcdefghij", nil,
+		},
+		{[]byte{0x02, 0x03, 0xB0}, "", ErrSignature},
+		{[]byte{0x01, 0x03, 0x00, 0x02, 0x61}, "", ErrChunk},
+		{[]byte{0x01, 0x03, 0xB0, 0x01, 0x01, 0x00}, "", ErrChunk},
+	}
+
+	for testId, test := range tests {
+		got, err := Decompress(test.compressed)
+		if err != test.err {
+			t.Errorf("Test [%d]: Expected error [%v], got [%v]", testId, test.err, err)
+		} else if err == nil && string(got) != test.expect {
+			t.Errorf("Test [%d]: Expected [%s], got [%s]", testId, test.expect, got)
+		}
+	}
+
+	// Raw chunks hold 4096 bytes uncompressed.
+	raw := bytes.Repeat([]byte("0123456789abcdef"), 256)
+	container := append([]byte{0x01, 0xFF, 0x3F}, raw...)
+	if got, err := Decompress(container); err != nil || !bytes.Equal(got, raw) {
+		t.Errorf("Raw chunk: Expected [%d] bytes, got [%d] bytes, error [%v]", len(raw), len(got), err)
+	}
+
+	long := bytes.Repeat([]byte("Sub Test()\r\nEnd Sub\r\n"), 400)
+	if got, err := Decompress(compress(long)); err != nil || !bytes.Equal(got, long) {
+		t.Errorf("Multiple chunks: Expected [%d] bytes, got [%d] bytes, error [%v]", len(long), len(got), err)
+	}
+}
+
+func uint16le(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, v)
+	return b
+}
+
+func uint32le(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+func record(id uint16, data []byte) []byte {
+	b := append(uint16le(id), uint32le(uint32(len(data)))...)
+	return append(b, data...)
+}
+
+func unicode(s string) (b []byte) {
+	for _, c := range utf16.Encode([]rune(s)) {
+		b = append(b, uint16le(c)...)
+	}
+	return
+}
+
+func TestProjects(t *testing.T) {
+	var dir []byte
+	dir = append(dir, record(PROJECTSYSKIND, []byte{1, 0, 0, 0})...)
+	dir = append(dir, record(PROJECTCODEPAGE, []byte{0xE4, 0x04})...)
+	dir = append(dir, record(PROJECTNAME, []byte("Project\xe9"))...)
+	dir = append(dir, PROJECTVERSION, 0, 4, 0, 0, 0, 1, 0, 0, 0, 2, 0)
+	dir = append(dir, record(PROJECTMODULES, []byte{2, 0})...)
+
+	modules := []struct {
+		name, stream, source string
+		procedural           bool
+	}{
+		{"ThisDocument", "ThisDocument", "Attribute VB_Name = \"ThisDocument\"\r\n", false},
+		{"Module1", "Module1", "Sub AutoOpen()\r\n  Shell \"calc\"\r\nEnd Sub\r\n", true},
+	}
+
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	macros, _ := root.AddStorage("Macros")
+	storage, _ := macros.AddStorage("VBA")
+
+	for _, m := range modules {
+		pcode := bytes.Repeat([]byte{0xCC}, 50)
+		dir = append(dir, record(MODULENAME, []byte(m.name))...)
+		dir = append(dir, record(MODULESTREAMNAME, []byte(m.stream))...)
+		dir = append(dir, record(MODULESTREAMNAMEUNICODE, unicode(m.stream))...)
+		dir = append(dir, record(MODULEOFFSET, uint32le(uint32(len(pcode))))...)
+		if m.procedural {
+			dir = append(dir, record(MODULETYPEPROCEDURAL, nil)...)
+		} else {
+			dir = append(dir, record(MODULETYPEDOCUMENT, nil)...)
+		}
+		dir = append(dir, record(MODULETERMINATOR, nil)...)
+		storage.AddStream(m.stream, append(pcode, compress([]byte(m.source))...))
+	}
+	dir = append(dir, record(DIRTERMINATOR, nil)...)
+	storage.AddStream("dir", compress(dir))
+
+	if !HasMacros(d) {
+		t.Errorf("Expected macros")
+	}
+
+	projects, err := Projects(d)
+	if err != nil {
+		t.Fatalf("Projects: unexpected error [%v]", err)
+	}
+	if len(projects) != 1 {
+		t.Fatalf("Expected [1] project, got [%d]", len(projects))
+	}
+
+	p := projects[0]
+	if p.Name != "Projecté" || p.CodePage != 1252 || len(p.Modules) != len(modules) {
+		t.Fatalf("Expected project [Projecté], got [%s] code page [%d] with [%d] modules", p.Name, p.CodePage, len(p.Modules))
+	}
+
+	for testId, test := range modules {
+		m := p.Module(test.name)
+		if m == nil {
+			t.Errorf("Test [%d]: module [%s] not found", testId, test.name)
+		} else if m.Source != test.source || m.Procedural != test.procedural {
+			t.Errorf("Test [%d]: Expected [%q %v], got [%q %v]", testId, test.source, test.procedural, m.Source, m.Procedural)
+		}
+	}
+
+	empty, _ := cfb.New()
+	if projects, err = Projects(empty); err != nil || len(projects) != 0 || HasMacros(empty) {
+		t.Errorf("Expected no projects, got [%d] error [%v]", len(projects), err)
+	}
+}
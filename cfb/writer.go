@@ -0,0 +1,382 @@
+package cfb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// WriteTo serialize the document, ie. all storages and streams reachable from the root entry.
+// Streams smaller than MiniSectorCutoff are placed in the mini stream, all other streams in regular sectors.
+// The output is always compact, no free sectors are written, so writing a modified document repack it.
+func (d *Document) WriteTo(w io.Writer) (n int64, err error) {
+	root, err := d.Root()
+	if err != nil {
+		return
+	}
+
+	l := &layout{doc: d}
+	if err = l.collect(root); err != nil {
+		return
+	}
+
+	l.allocate()
+	return l.writeTo(w)
+}
+
+// WriteFile write the document to a file, replacing it if it exists. The document is written to a temporary file first,
+// so a document may be written back to the file it was opened from, eg. after modifying or deleting streams.
+func (d *Document) WriteFile(filename string) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*")
+	if err != nil {
+		return
+	}
+
+	if fi, serr := os.Stat(filename); serr == nil {
+		f.Chmod(fi.Mode())
+	}
+
+	_, err = d.WriteTo(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), filename)
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return
+}
+
+// layout hold the sector allocation of a document being written.
+type layout struct {
+	doc        *Document
+	header     Header
+	entries    []*DirectoryEntry
+	dirs       []Directory
+	contents   [][]byte // Stream content, per entry, for streams placed in regular sectors
+	ministream []byte
+	minifat    []uint32
+	fat        []uint32
+	fatSectors []uint32
+	difat      []uint32 // Serialized DIFAT sectors, sector by sector
+	sectors    uint32   // Number of sectors following the header
+}
+
+func (l *layout) collect(root *DirectoryEntry) (err error) {
+	root.Walk(func(de *DirectoryEntry) {
+		l.entries = append(l.entries, de)
+	})
+
+	ids := make(map[*DirectoryEntry]uint32, len(l.entries))
+	for i, de := range l.entries {
+		ids[de] = uint32(i)
+	}
+
+	l.dirs = make([]Directory, len(l.entries))
+	l.contents = make([][]byte, len(l.entries))
+	for i, de := range l.entries {
+		l.dirs[i] = de.Directory
+		l.dirs[i].LeftSibling = NOSTREAM
+		l.dirs[i].RightSibling = NOSTREAM
+		l.dirs[i].Child = NOSTREAM
+
+		if de.Type == STGTY_STREAM {
+			if l.contents[i], err = de.content(); err != nil {
+				return
+			}
+		}
+	}
+
+	for i, de := range l.entries {
+		if len(de.children) == 0 {
+			continue
+		}
+
+		children := make([]uint32, len(de.children))
+		for j, child := range de.children {
+			children[j] = ids[child]
+		}
+		sort.Slice(children, func(a, b int) bool {
+			return CompareNames(l.dirs[children[a]].rawName(), l.dirs[children[b]].rawName()) < 0
+		})
+
+		redDepth := -1
+		if !isPerfect(len(children)) {
+			redDepth = treeDepth(len(children))
+		}
+		l.dirs[i].Child = l.tree(children, 0, redDepth)
+	}
+
+	l.dirs[0].Type = STGTY_ROOT
+	return
+}
+
+// tree build a balanced binary search tree from the sorted entry IDs, returning the tree root.
+// Nodes are black except those at redDepth, the incomplete bottom level, which satisfy the red-black properties.
+func (l *layout) tree(ids []uint32, depth, redDepth int) uint32 {
+	if len(ids) == 0 {
+		return NOSTREAM
+	}
+
+	mid := len(ids) / 2
+	id := ids[mid]
+
+	dir := &l.dirs[id]
+	dir.Flags = DE_BLACK
+	if depth == redDepth {
+		dir.Flags = DE_RED
+	}
+	dir.LeftSibling = l.tree(ids[:mid], depth+1, redDepth)
+	dir.RightSibling = l.tree(ids[mid+1:], depth+1, redDepth)
+	return id
+}
+
+func (l *layout) allocate() {
+	d := l.doc
+	cutoff := int(d.MiniSectorCutoff)
+	miniSectorSize := int(d.miniSectorSize)
+
+	// Mini stream first, the sizes of the regular sector chains depend on it.
+	for i := range l.dirs {
+		dir := &l.dirs[i]
+		if dir.Type != STGTY_STREAM {
+			continue
+		}
+
+		content := l.contents[i]
+		dir.Size = uint32(len(content))
+		dir.Start = ENDOFCHAIN
+		if len(content) == 0 || len(content) >= cutoff {
+			continue
+		}
+
+		count := (len(content) + miniSectorSize - 1) / miniSectorSize
+		dir.Start = uint32(len(l.minifat))
+		l.minifat = appendChain(l.minifat, dir.Start, uint32(count))
+		l.ministream = append(l.ministream, content...)
+		l.ministream = append(l.ministream, make([]byte, count*miniSectorSize-len(content))...)
+		l.contents[i] = nil
+	}
+
+	// Regular sectors: directory, mini FAT, mini stream and streams, followed by FAT and DIFAT sectors.
+	dirStart := l.chain(uint32(len(l.dirs) * DirectorySize))
+	miniFatStart := l.chain(uint32(len(l.minifat) * 4))
+	miniStreamStart := l.chain(uint32(len(l.ministream)))
+	for i := range l.dirs {
+		if len(l.contents[i]) > 0 {
+			l.dirs[i].Start = l.chain(uint32(len(l.contents[i])))
+		}
+	}
+
+	perSector := d.sectorSize / 4
+	var fatCount, difatCount uint32
+	for {
+		difatCount = 0
+		if fatCount > uint32(len(d.Fat)) {
+			difatCount = (fatCount - uint32(len(d.Fat)) + perSector - 2) / (perSector - 1)
+		}
+		if l.sectors+fatCount+difatCount <= fatCount*perSector {
+			break
+		}
+		fatCount++
+	}
+
+	for i := uint32(0); i < fatCount; i++ {
+		l.fatSectors = append(l.fatSectors, l.sectors)
+		l.fat = append(l.fat, FATSECT)
+		l.sectors++
+	}
+
+	difatStart := uint32(ENDOFCHAIN)
+	if difatCount > 0 {
+		difatStart = l.sectors
+	}
+	for i := uint32(0); i < difatCount; i++ {
+		l.fat = append(l.fat, DIFSECT)
+		l.sectors++
+	}
+
+	for uint32(len(l.fat)) < fatCount*perSector {
+		l.fat = append(l.fat, FREESECT)
+	}
+
+	// The DIFAT holds the FAT sector IDs not in the header, each sector ending with the ID of the next DIFAT sector.
+	remaining := l.fatSectors
+	if uint32(len(remaining)) > uint32(len(d.Fat)) {
+		remaining = remaining[len(d.Fat):]
+	} else {
+		remaining = nil
+	}
+	for i := uint32(0); i < difatCount; i++ {
+		sector := make([]uint32, perSector)
+		for j := range sector {
+			sector[j] = FREESECT
+		}
+		remaining = remaining[copy(sector[:perSector-1], remaining):]
+
+		sector[perSector-1] = ENDOFCHAIN
+		if i+1 < difatCount {
+			sector[perSector-1] = difatStart + i + 1
+		}
+		l.difat = append(l.difat, sector...)
+	}
+
+	// Root entry refer to the mini stream
+	l.dirs[0].Start = miniStreamStart
+	l.dirs[0].Size = uint32(len(l.ministream))
+
+	h := &l.header
+	*h = d.Header
+	h.Signature = Signature
+	h.ByteOrder = 0xFFFE
+	h.SectFAT = fatCount
+	h.SectDirStart = dirStart
+	h.SectDir = 0
+	if d.MajorVersion == 4 {
+		h.SectDir = (uint32(len(l.dirs))*DirectorySize + d.sectorSize - 1) / d.sectorSize
+	}
+	h.MiniFatStart = miniFatStart
+	h.MiniFat = (uint32(len(l.minifat))*4 + d.sectorSize - 1) / d.sectorSize
+	h.DifStart = difatStart
+	h.Dif = difatCount
+	for i := range h.Fat {
+		h.Fat[i] = FREESECT
+	}
+	copy(h.Fat[:], l.fatSectors)
+}
+
+// chain allocate a contiguous sector chain for size bytes and return the first sector ID.
+func (l *layout) chain(size uint32) (start uint32) {
+	if size == 0 {
+		return ENDOFCHAIN
+	}
+
+	count := (size + l.doc.sectorSize - 1) / l.doc.sectorSize
+	start = l.sectors
+	l.fat = appendChain(l.fat, start, count)
+	l.sectors += count
+	return
+}
+
+func (l *layout) writeTo(w io.Writer) (n int64, err error) {
+	d := l.doc
+	cw := &countWriter{w: w}
+
+	var b bytes.Buffer
+	if err = binary.Write(&b, d.byteOrder, &l.header); err != nil {
+		return
+	}
+	padBuffer(&b, d.sectorSize)
+
+	emptyDir := Directory{LeftSibling: NOSTREAM, RightSibling: NOSTREAM, Child: NOSTREAM}
+	for i := range l.dirs {
+		writeDirectory(&b, d.byteOrder, &l.dirs[i])
+	}
+	for uint32(b.Len())%d.sectorSize != 0 {
+		writeDirectory(&b, d.byteOrder, &emptyDir)
+	}
+
+	if err = writeUint32s(&b, d.byteOrder, l.minifat, FREESECT, d.sectorSize); err != nil {
+		return
+	}
+
+	b.Write(l.ministream)
+	padBuffer(&b, d.sectorSize)
+
+	if _, err = cw.Write(b.Bytes()); err != nil {
+		return cw.n, err
+	}
+
+	for _, content := range l.contents {
+		if len(content) == 0 {
+			continue
+		}
+
+		b.Reset()
+		b.Write(content)
+		padBuffer(&b, d.sectorSize)
+		if _, err = cw.Write(b.Bytes()); err != nil {
+			return cw.n, err
+		}
+	}
+
+	b.Reset()
+	if err = writeUint32s(&b, d.byteOrder, l.fat, FREESECT, d.sectorSize); err != nil {
+		return
+	}
+	if err = writeUint32s(&b, d.byteOrder, l.difat, FREESECT, d.sectorSize); err != nil {
+		return
+	}
+
+	_, err = cw.Write(b.Bytes())
+	return cw.n, err
+}
+
+func appendChain(fat []uint32, start, count uint32) []uint32 {
+	for i := uint32(1); i < count; i++ {
+		fat = append(fat, start+i)
+	}
+	if count > 0 {
+		fat = append(fat, ENDOFCHAIN)
+	}
+	return fat
+}
+
+func writeDirectory(b *bytes.Buffer, byteOrder binary.ByteOrder, dir *Directory) {
+	start := b.Len()
+	binary.Write(b, byteOrder, dir)
+	b.Write(make([]byte, DirectorySize-(b.Len()-start)))
+}
+
+func writeUint32s(b *bytes.Buffer, byteOrder binary.ByteOrder, values []uint32, pad uint32, sectorSize uint32) (err error) {
+	if len(values) == 0 {
+		return
+	}
+
+	if err = binary.Write(b, byteOrder, values); err != nil {
+		return
+	}
+
+	fill := make([]byte, 4)
+	byteOrder.PutUint32(fill, pad)
+	for uint32(b.Len())%sectorSize != 0 {
+		b.Write(fill)
+	}
+	return
+}
+
+func padBuffer(b *bytes.Buffer, sectorSize uint32) {
+	if rest := uint32(b.Len()) % sectorSize; rest != 0 {
+		b.Write(make([]byte, sectorSize-rest))
+	}
+}
+
+// treeDepth return the depth of the deepest node in a balanced tree of n nodes.
+func treeDepth(n int) (depth int) {
+	for ; n > 1; n >>= 1 {
+		depth++
+	}
+	return
+}
+
+func isPerfect(n int) bool {
+	return n&(n+1) == 0
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (n int, err error) {
+	n, err = c.w.Write(p)
+	c.n += int64(n)
+	return
+}
@@ -0,0 +1,147 @@
+package cfb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func testContent(size int, seed byte) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = seed + byte(i*7)
+	}
+	return b
+}
+
+func TestWriteTo(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{"Empty", 0},
+		{"Tiny", 1},
+		{"Mini", 100},
+		{"MiniLast", 0x0FFF},
+		{"Cutoff", 0x1000},
+		{"Large", 100000},
+	}
+
+	d, err := New()
+	if err != nil {
+		t.Fatalf("New: unexpected error [%v]", err)
+	}
+
+	root, _ := d.Root()
+	storage, err := root.AddStorage("Storage")
+	if err != nil {
+		t.Fatalf("AddStorage: unexpected error [%v]", err)
+	}
+
+	for i, test := range tests {
+		if _, err = root.AddStream(test.name, testContent(test.size, byte(i))); err != nil {
+			t.Fatalf("Test [%d]: AddStream error [%v]", i, err)
+		}
+		if _, err = storage.AddStream(test.name, testContent(test.size, byte(i+1))); err != nil {
+			t.Fatalf("Test [%d]: AddStream error [%v]", i, err)
+		}
+	}
+
+	if _, err = root.AddStream("storage", nil); err != ErrNameExists {
+		t.Errorf("Expected error [%v], got [%v]", ErrNameExists, err)
+	}
+
+	var b bytes.Buffer
+	n, err := d.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+	if n != int64(b.Len()) || n%512 != 0 {
+		t.Errorf("WriteTo: unexpected length [%d], buffer length [%d]", n, b.Len())
+	}
+
+	r, err := New()
+	if err != nil {
+		t.Fatalf("New: unexpected error [%v]", err)
+	}
+	if _, err = r.ReadFrom(&b); err != nil {
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	found := make(map[string][]byte)
+	r.Walk(func(de *DirectoryEntry) {
+		if de.Type != STGTY_STREAM {
+			return
+		}
+
+		s, err := de.Stream()
+		if err != nil {
+			t.Errorf("Stream [%s]: unexpected error [%v]", de.FullName(), err)
+			return
+		}
+
+		var content bytes.Buffer
+		io.Copy(&content, s)
+		found[de.FullName()] = content.Bytes()
+	})
+
+	for i, test := range tests {
+		for j, name := range []string{test.name, "Storage/" + test.name} {
+			expect := testContent(test.size, byte(i+j))
+			if got, ok := found[name]; !ok {
+				t.Errorf("Test [%d]: stream [%s] not found", i, name)
+			} else if !bytes.Equal(got, expect) {
+				t.Errorf("Test [%d]: stream [%s] content mismatch, expected %d bytes, got %d bytes", i, name, len(expect), len(got))
+			}
+		}
+	}
+}
+
+func TestWriteToManyEntries(t *testing.T) {
+	d, _ := New()
+	root, _ := d.Root()
+
+	count := 300
+	for i := 0; i < count; i++ {
+		if _, err := root.AddStream(fmt.Sprintf("Stream%d", i), testContent(i, byte(i))); err != nil {
+			t.Fatalf("AddStream [%d]: unexpected error [%v]", i, err)
+		}
+	}
+
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	r, _ := New()
+	if _, err := r.ReadFrom(&b); err != nil {
+		t.Fatalf("ReadFrom: unexpected error [%v]", err)
+	}
+
+	rroot, err := r.Root()
+	if err != nil {
+		t.Fatalf("Root: unexpected error [%v]", err)
+	}
+	if len(rroot.Children()) != count {
+		t.Errorf("Expected [%d] children, got [%d]", count, len(rroot.Children()))
+	}
+}
+
+func TestCompareNames(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		expect int
+	}{
+		{"a", "b", -1},
+		{"abc", "ABC", 0},
+		{"b", "aa", -1},
+		{"Zz", "zA", 1},
+	}
+
+	for testId, test := range tests {
+		if got := CompareNames(test.a, test.b); got != test.expect {
+			t.Errorf("Test [%d]: Expected [%d], got [%d]", testId, test.expect, got)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// cfbtool inspect and extract compound files (.doc, .xls, .msg etc).
+//
+// Usage:
+//
+//	cfbtool [-recover] ls <file>
+//	cfbtool [-recover] cat <file> <path>
+//	cfbtool [-recover] extract <file> <dir>
+//	cfbtool [-recover] info <file>
+//	cfbtool [-recover] check <file>
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+var errUsage = errors.New("Bad arguments")
+
+var commands = map[string]struct {
+	args int // Arguments following the file name
+	run  func(d *cfb.Document, args []string) error
+}{
+	"ls":      {0, list},
+	"cat":     {1, cat},
+	"extract": {1, extract},
+	"info":    {0, info},
+	"check":   {0, check},
+}
+
+func main() {
+	recovery := flag.Bool("recover", false, "Open damaged files in recovery mode")
+	flag.Usage = usage
+	flag.Parse()
+
+	if err := run(flag.Args(), *recovery); err != nil {
+		fmt.Fprintln(os.Stderr, "cfbtool:", err)
+		if err == errUsage {
+			usage()
+		}
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: cfbtool [-recover] <command> <file> [args]
+
+Commands:
+  ls <file>               List the directory tree with types and sizes
+  cat <file> <path>       Write a stream to stdout, eg. "__substg1.0_0037001F"
+  extract <file> <dir>    Extract storages and streams to a directory
+  info <file>             Show header fields, sector sizes and FAT statistics
+  check <file>            Validate the file structure, exit status 1 if damaged
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
+
+func run(args []string, recovery bool) (err error) {
+	if len(args) < 2 {
+		return errUsage
+	}
+
+	command, ok := commands[args[0]]
+	if !ok || len(args) != command.args+2 {
+		return errUsage
+	}
+
+	f, err := os.Open(args[1])
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return
+	}
+
+	var d *cfb.Document
+	if recovery {
+		d, err = cfb.OpenRecovery(f, fi.Size())
+	} else {
+		d, err = cfb.Open(f, fi.Size())
+	}
+	if err != nil {
+		return
+	}
+
+	if err = command.run(d, args[2:]); err == nil && recovery {
+		for _, loss := range d.Losses() {
+			fmt.Fprintln(os.Stderr, "lost:", loss)
+		}
+	}
+	return
+}
+
+func list(d *cfb.Document, args []string) (err error) {
+	entries, err := d.Listing()
+	if err != nil {
+		return
+	}
+
+	for _, e := range entries {
+		fmt.Println(e)
+	}
+	return
+}
+
+func cat(d *cfb.Document, args []string) (err error) {
+	entry, err := d.Lookup(args[0])
+	if err != nil {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+
+	s, err := entry.Stream()
+	if err != nil {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+
+	_, err = io.Copy(os.Stdout, s)
+	return
+}
+
+func extract(d *cfb.Document, args []string) (err error) {
+	root, err := d.Root()
+	if err != nil {
+		return
+	}
+	return extractEntry(root, args[0])
+}
+
+func extractEntry(entry *cfb.DirectoryEntry, dir string) (err error) {
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+
+	used := make(map[string]bool)
+	for _, child := range entry.Children() {
+		base := fileName(child.Name())
+		if unique := uniqueName(used, base); unique != base {
+			fmt.Fprintf(os.Stderr, "renamed: %s to %s\n", child.Path(), unique)
+			base = unique
+		}
+
+		name := filepath.Join(dir, base)
+		if child.Type != cfb.STGTY_STREAM {
+			err = extractEntry(child, name)
+		} else {
+			err = extractStream(child, name)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func extractStream(entry *cfb.DirectoryEntry, name string) (err error) {
+	s, err := entry.Stream()
+	if err != nil {
+		return fmt.Errorf("%s: %w", entry.Path(), err)
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		return
+	}
+
+	_, err = io.Copy(f, s)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	if damage := entry.Damage(); damage != nil {
+		fmt.Fprintf(os.Stderr, "damaged: %s, %v, recovered %d of %d bytes\n", entry.Path(), damage.Err, damage.Recovered, damage.Size)
+	}
+	return
+}
+
+// fileName replace characters not allowed in file names on common file systems.
+func fileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if !unicode.IsPrint(r) || strings.ContainsRune(`<>:"/\|?*`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	if name == "" || name == "." || name == ".." {
+		name = "_" + name
+	}
+	return name
+}
+
+// uniqueName return the name, or the name with a "~N" suffix if already used in the directory. Names are compared case
+// insensitive, as on Windows and macOS file systems.
+func uniqueName(used map[string]bool, name string) string {
+	unique := name
+	for i := 1; used[strings.ToLower(unique)]; i++ {
+		unique = fmt.Sprintf("%s~%d", name, i)
+	}
+	used[strings.ToLower(unique)] = true
+	return unique
+}
+
+func info(d *cfb.Document, args []string) (err error) {
+	report, err := d.Validate()
+	if err != nil {
+		return
+	}
+
+	fmt.Printf("Version:            %d.%d\n", d.MajorVersion, d.MinorVersion)
+	fmt.Printf("CLSID:              %s\n", d.CLSID)
+	fmt.Printf("Sector size:        %d\n", 1<<d.SectorShift)
+	fmt.Printf("Mini sector size:   %d\n", 1<<d.MiniSectorShift)
+	fmt.Printf("Mini stream cutoff: %d\n", d.MiniSectorCutoff)
+	fmt.Printf("Directory start:    %d\n", d.SectDirStart)
+	fmt.Printf("FAT sectors:        %d\n", d.SectFAT)
+	fmt.Printf("Mini FAT sectors:   %d (start %d)\n", d.MiniFat, d.MiniFatStart)
+	fmt.Printf("DIFAT sectors:      %d (start %d)\n", d.Dif, d.DifStart)
+	fmt.Printf("Sectors:            %d\n", report.Sectors)
+	fmt.Printf("Free sectors:       %d\n", report.Free)
+	fmt.Printf("Orphan sectors:     %d\n", report.Orphans)
+	return
+}
+
+func check(d *cfb.Document, args []string) (err error) {
+	report, err := d.Validate()
+	if err != nil {
+		return
+	}
+
+	for _, f := range report.Findings {
+		level := "error"
+		if f.Warning {
+			level = "warning"
+		}
+		fmt.Printf("%s: %s\n", level, f)
+	}
+
+	if report.Damaged() {
+		return errors.New("File is damaged")
+	}
+
+	if report.Valid() {
+		fmt.Println("ok")
+	}
+	return
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// testFile write a document with a storage and streams whose file names collide, return the file name.
+func testFile(t *testing.T) string {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream("a*b", []byte("first"))
+	root.AddStream("a?b", []byte("second"))
+	storage, _ := root.AddStorage("Storage")
+	storage.AddStream("Stream", []byte("nested"))
+
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	name := filepath.Join(t.TempDir(), "test.cfb")
+	if err := os.WriteFile(name, b.Bytes(), 0644); err != nil {
+		t.Fatalf("WriteFile: unexpected error [%v]", err)
+	}
+	return name
+}
+
+// capture return what f write to stdout.
+func capture(t *testing.T, f func() error) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: unexpected error [%v]", err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	err = f()
+	os.Stdout = stdout
+	w.Close()
+
+	if err != nil {
+		t.Fatalf("Unexpected error [%v]", err)
+	}
+
+	b, _ := io.ReadAll(r)
+	return string(b)
+}
+
+func TestList(t *testing.T) {
+	name := testFile(t)
+
+	out := capture(t, func() error { return run([]string{"ls", name}, false) })
+	for testId, expected := range []string{"a*b", "a?b", "Storage", "Stream"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Test [%d]: Expected [%s] listed, got [%s]", testId, expected, out)
+		}
+	}
+
+	if err := run([]string{"ls"}, false); err != errUsage {
+		t.Errorf("Expected [%v], got [%v]", errUsage, err)
+	}
+}
+
+func TestExtract(t *testing.T) {
+	name := testFile(t)
+	dir := filepath.Join(t.TempDir(), "out")
+
+	capture(t, func() error { return run([]string{"extract", name, dir}, false) })
+
+	tests := []struct {
+		path, content string
+	}{
+		{"a_b", ""},
+		{"a_b~1", ""},
+		{filepath.Join("Storage", "Stream"), "nested"},
+	}
+
+	var collided []string
+	for testId, test := range tests {
+		b, err := os.ReadFile(filepath.Join(dir, test.path))
+		if err != nil {
+			t.Errorf("Test [%d]: unexpected error [%v]", testId, err)
+		} else if test.content == "" {
+			collided = append(collided, string(b)) // Extraction order follow the directory tree
+		} else if string(b) != test.content {
+			t.Errorf("Test [%d]: Expected [%s], got [%s]", testId, test.content, b)
+		}
+	}
+
+	sort.Strings(collided)
+	if strings.Join(collided, " ") != "first second" {
+		t.Errorf("Expected both colliding streams extracted, got [%v]", collided)
+	}
+}
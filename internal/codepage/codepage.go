@@ -0,0 +1,76 @@
+// package codepage decode strings in Windows code pages and MIME charsets, as used by property sets, VBA projects, RTF
+// and message headers.
+package codepage
+
+import (
+	"io"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/transform"
+)
+
+var codePages = map[uint16]encoding.Encoding{
+	437:   charmap.CodePage437,
+	850:   charmap.CodePage850,
+	852:   charmap.CodePage852,
+	855:   charmap.CodePage855,
+	858:   charmap.CodePage858,
+	860:   charmap.CodePage860,
+	862:   charmap.CodePage862,
+	863:   charmap.CodePage863,
+	865:   charmap.CodePage865,
+	866:   charmap.CodePage866,
+	874:   charmap.Windows874,
+	932:   japanese.ShiftJIS,
+	936:   simplifiedchinese.GBK,
+	949:   korean.EUCKR,
+	950:   traditionalchinese.Big5,
+	1250:  charmap.Windows1250,
+	1251:  charmap.Windows1251,
+	1252:  charmap.Windows1252,
+	1253:  charmap.Windows1253,
+	1254:  charmap.Windows1254,
+	1255:  charmap.Windows1255,
+	1256:  charmap.Windows1256,
+	1257:  charmap.Windows1257,
+	1258:  charmap.Windows1258,
+	10000: charmap.Macintosh,
+	20866: charmap.KOI8R,
+	21866: charmap.KOI8U,
+	28591: charmap.ISO8859_1,
+	28592: charmap.ISO8859_2,
+	28593: charmap.ISO8859_3,
+	28594: charmap.ISO8859_4,
+	28595: charmap.ISO8859_5,
+	28596: charmap.ISO8859_6,
+	28597: charmap.ISO8859_7,
+	28598: charmap.ISO8859_8,
+	28599: charmap.ISO8859_9,
+	28605: charmap.ISO8859_15,
+}
+
+// Decode decode a string in the given code page. Strings in UTF-8 or unknown code pages are returned as is.
+func Decode(b []byte, codePage uint16) string {
+	if enc, ok := codePages[codePage]; ok {
+		if s, err := enc.NewDecoder().Bytes(b); err == nil {
+			return string(s)
+		}
+	}
+	return string(b)
+}
+
+// CharsetReader return a reader decoding the named charset, eg. "iso-8859-1" or "windows-1252" of MIME, to UTF-8.
+// The signature match mime.WordDecoder.CharsetReader.
+func CharsetReader(charset string, r io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewReader(r, enc.NewDecoder()), nil
+}
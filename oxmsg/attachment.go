@@ -0,0 +1,101 @@
+package oxmsg
+
+import (
+	"io"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// AttachMethod is the value of PidTagAttachMethod.
+type AttachMethod uint32
+
+const (
+	AttachNone            AttachMethod = 0x0000
+	AttachByValue         AttachMethod = 0x0001 // Data in PidTagAttachDataBinary
+	AttachByReference     AttachMethod = 0x0002
+	AttachByReferenceOnly AttachMethod = 0x0004
+	AttachEmbeddedMessage AttachMethod = 0x0005 // Message in the PidTagAttachDataObject storage
+	AttachStorage         AttachMethod = 0x0006 // OLE storage in PidTagAttachDataObject
+	AttachByWebReference  AttachMethod = 0x0007
+)
+
+// Attachment is an attachment storage, __attach_version1.0_#XXXXXXXX, of a message.
+type Attachment struct {
+	FileName  string // PidTagAttachLongFilename, or PidTagAttachFilename if no long name
+	MimeType  string // PidTagAttachMimeTag
+	ContentID string // PidTagAttachContentId
+	Size      int64  // PidTagAttachSize, or the size of the attachment data if not present
+	Method    AttachMethod
+
+	object
+	message *Message
+}
+
+// Attachments return the attachments of the message, ordered by attachment number.
+func (m *Message) Attachments() (attachments []*Attachment, err error) {
+	storage, err := m.Storage()
+	if err != nil {
+		return
+	}
+
+	for _, child := range children(storage, AttachmentPrefix) {
+		attachments = append(attachments, newAttachment(m, child))
+	}
+	return
+}
+
+func newAttachment(m *Message, storage *cfb.DirectoryEntry) (a *Attachment) {
+	a = &Attachment{object: newObject(storage, headerObject, m.NameMap()), message: m}
+	a.FileName = a.string(PidTagAttachLongFilename, PidTagAttachFilename)
+	a.MimeType = a.string(PidTagAttachMimeTag)
+	a.ContentID = a.string(PidTagAttachContentId)
+
+	data := a.Get(PidTagAttachDataBinary)
+	if data != nil && !data[0].IsFixed() {
+		a.Size = int64(data[0].Size)
+	}
+	if e := a.Get(PidTagAttachSize); e != nil {
+		if v, err := e[0].Uint32(); err == nil {
+			a.Size = int64(v)
+		}
+	}
+
+	if e := a.Get(PidTagAttachMethod); e != nil {
+		if v, err := e[0].Uint32(); err == nil {
+			a.Method = AttachMethod(v)
+			return
+		}
+	}
+
+	// Infer the method from the data present
+	if object := a.Get(PidTagAttachDataObject); object != nil && object[0].IsStorage() {
+		if _, err := object[0].Lookup(PropertiesStreamName); err == nil {
+			a.Method = AttachEmbeddedMessage
+		} else {
+			a.Method = AttachStorage
+		}
+	} else if data != nil {
+		a.Method = AttachByValue
+	}
+	return
+}
+
+// Open return a reader of the attachment data, PidTagAttachDataBinary.
+func (a *Attachment) Open() (r io.Reader, err error) {
+	entries := a.Get(PidTagAttachDataBinary)
+	if entries == nil {
+		return nil, ErrPropertyNotFound
+	}
+	return entries[0].Stream()
+}
+
+// Message return the embedded message of an attachment with method AttachEmbeddedMessage.
+func (a *Attachment) Message() (m *Message, err error) {
+	entries := a.Get(PidTagAttachDataObject)
+	if a.Method != AttachEmbeddedMessage || entries == nil || !entries[0].IsStorage() {
+		return nil, ErrNotEmbedded
+	}
+
+	m = &Message{Document: a.message.Document, storage: entries[0].DirectoryEntry, names: a.names}
+	return
+}
@@ -0,0 +1,127 @@
+package oxmsg
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AuthenticationResults is an Authentication-Results field, RFC 8601.
+type AuthenticationResults struct {
+	ServID  string // Authentication service identifier, usually the host name of the receiving server
+	Version int    // 0 if not given, ie. version 1
+	Results []*AuthResult
+}
+
+// AuthResult is the result of one authentication method, eg. "spf=pass smtp.mailfrom=example.com".
+type AuthResult struct {
+	Method     string            // Lower case, eg. "dkim", "spf", "dmarc" or "arc"
+	Result     string            // Lower case, eg. "pass", "fail" or "none"
+	Reason     string            // The reason property, if given
+	Comment    string            // Comments of the result joined, eg. "google.com: domain of ... designates ..."
+	Properties map[string]string // Properties by lower case "ptype.property", eg. "smtp.mailfrom" or "header.d"
+}
+
+// Result return the first result of the method, nil if none.
+func (a *AuthenticationResults) Result(method string) *AuthResult {
+	for _, r := range a.Results {
+		if strings.EqualFold(r.Method, method) {
+			return r
+		}
+	}
+	return nil
+}
+
+// ParseAuthenticationResults parse the value of an Authentication-Results field. A value starting with a result, as
+// written by Exchange Online, has no authserv-id and ServID is "".
+func ParseAuthenticationResults(value string) (a *AuthenticationResults, err error) {
+	parts := splitStructured(value, ';')
+
+	a = new(AuthenticationResults)
+	if id, _ := stripComments(parts[0]); !strings.Contains(id, "=") {
+		fields := strings.Fields(id)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, ErrAuthResults
+		}
+
+		a.ServID = fields[0]
+		if len(fields) == 2 {
+			if a.Version, err = strconv.Atoi(fields[1]); err != nil {
+				return nil, ErrAuthResults
+			}
+		}
+		parts = parts[1:]
+	}
+
+	for _, part := range parts {
+		text, comments := stripComments(part)
+		if text = strings.TrimSpace(text); text == "" || strings.EqualFold(text, "none") {
+			continue
+		}
+
+		var pairs [][2]string
+		if pairs, err = parsePairs(text); err != nil {
+			return nil, err
+		}
+
+		r := &AuthResult{
+			Method:     strings.ToLower(strings.SplitN(pairs[0][0], "/", 2)[0]),
+			Result:     strings.ToLower(pairs[0][1]),
+			Comment:    strings.Join(comments, " "),
+			Properties: make(map[string]string),
+		}
+		for _, pair := range pairs[1:] {
+			if key := strings.ToLower(pair[0]); key == "reason" {
+				r.Reason = pair[1]
+			} else {
+				r.Properties[key] = pair[1]
+			}
+		}
+		a.Results = append(a.Results, r)
+	}
+	return
+}
+
+// parsePairs parse whitespace separated key=value pairs, where values may be quoted strings.
+func parsePairs(s string) (pairs [][2]string, err error) {
+	for i := 0; ; {
+		for ; i < len(s) && (s[i] == ' ' || s[i] == '\t'); i++ {
+		}
+		if i >= len(s) {
+			break
+		}
+
+		start := i
+		for ; i < len(s) && s[i] != '=' && s[i] != ' ' && s[i] != '\t'; i++ {
+		}
+		key := s[start:i]
+
+		for ; i < len(s) && (s[i] == ' ' || s[i] == '\t'); i++ {
+		}
+		if i >= len(s) || s[i] != '=' || key == "" {
+			return nil, ErrAuthResults
+		}
+		for i++; i < len(s) && (s[i] == ' ' || s[i] == '\t'); i++ {
+		}
+
+		var value strings.Builder
+		if i < len(s) && s[i] == '"' {
+			for i++; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				value.WriteByte(s[i])
+			}
+			i++ // Closing quote
+		} else {
+			for ; i < len(s) && s[i] != ' ' && s[i] != '\t'; i++ {
+				value.WriteByte(s[i])
+			}
+		}
+		pairs = append(pairs, [2]string{key, value.String()})
+	}
+
+	if pairs == nil {
+		err = ErrAuthResults
+	}
+	return
+}
@@ -17,21 +17,21 @@ func (b *Block) Lines() []string {
 	return b.lines
 }
 
+// Map return the values of the lines by field name. Lines that are not fields are mapped to the empty name.
 func (b *Block) Map() (m map[string][]string) {
 	m = make(map[string][]string)
 	for _, line := range b.lines {
-		s := strings.SplitN(line, ":", 2)
-		m[s[0]] = append(m[s[0]], s[1])
+		name, value, _ := splitField(line)
+		m[name] = append(m[name], strings.TrimSpace(value))
 	}
 	return
 }
 
 func (b *Block) ScanMail(callback func(key, mail string)) {
 	for _, line := range b.lines {
-		s := strings.SplitN(line, ":", 2)
-		line = s[1]
+		key, line, _ := splitField(line)
 		for i, l := 0, len(line); i < l; i++ {
-			if line[i] != '@' {
+			if line[i] != '@' || i == 0 {
 				continue
 			}
 
@@ -61,51 +61,46 @@ func (b *Block) ScanMail(callback func(key, mail string)) {
 				continue
 			}
 
-			callback(s[0], string(line[start:end]))
+			callback(key, string(line[start:end]))
 		}
 	}
 }
 
 func (b *Block) ScanIP(callback func(key string, ip net.IP)) {
 	for _, line := range b.lines {
-		s := strings.SplitN(line, ":", 2)
-		line = s[1]
-		for i, l := 0, len(line); i < l; i++ {
-			c := line[i] //& 0xDF // Make character uppercase - has no effect on digits
-			if c-'0' >= 10 && (c&0xDF)-'A' >= 6 {
-				continue
-			}
+		key, line, _ := splitField(line)
+		scanIP(line, func(ip net.IP) { callback(key, ip) })
+	}
+}
+
+// scanIP call back with each IPv4 or IPv6 address found in s.
+func scanIP(s string, callback func(ip net.IP)) {
+	for i, l := 0, len(s); i < l; i++ {
+		c := s[i] //& 0xDF // Make character uppercase - has no effect on digits
+		if c-'0' >= 10 && (c&0xDF)-'A' >= 6 {
+			continue
+		}
 
-			start, part, separators := i, 0, 0
-			for ; i < l; i++ {
-				c := line[i]
-				if c-'0' < 10 || (c&0xDF)-'A' < 6 {
-					part++
-					if part > 4 {
-						break
-					}
-				} else if c == '.' || c == ':' {
-					separators++
-					part = 0
-				} else {
+		start, part, separators := i, 0, 0
+		for ; i < l; i++ {
+			c := s[i]
+			if c-'0' < 10 || (c&0xDF)-'A' < 6 {
+				part++
+				if part > 4 {
 					break
 				}
+			} else if c == '.' || c == ':' {
+				separators++
+				part = 0
+			} else {
+				break
 			}
+		}
 
-			if separators >= 3 {
-				if ip := net.ParseIP(string(line[start:i])); ip != nil {
-					callback(s[0], ip)
-				}
+		if separators >= 3 {
+			if ip := net.ParseIP(string(s[start:i])); ip != nil {
+				callback(ip)
 			}
 		}
 	}
 }
-
-func (b *Block) add(line string, continued bool) {
-	strings.TrimSpace(line)
-	if continued {
-		b.lines[len(b.lines)-1] += line
-	} else {
-		b.lines = append(b.lines, line)
-	}
-}
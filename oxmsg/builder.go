@@ -0,0 +1,550 @@
+package oxmsg
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// CLSID_Message is the class ID of the root storage of a message file.
+var CLSID_Message = cfb.GUID{0x00020D0B, 0x0000, 0x0000, [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+
+const (
+	STORE_UNICODE_OK = 0x00040000 // PidTagStoreSupportMask flag, strings are PtypString
+
+	nameIDBucketCount = 0x1F
+)
+
+type builderValue struct {
+	id       PropertyID     // Tag ID, unless named
+	named    *NamedProperty // Named property, the ID is assigned when written
+	propType PropertyType
+	data     []byte   // Inline value of fixed size types, otherwise the stream
+	values   [][]byte // Value streams of PtypMultipleString, PtypMultipleString8 and PtypMultipleBinary
+}
+
+// PropertyBuilder hold the property values of a message, recipient or attachment being built. Values are given as
+// decoded by Entry.Value, ie. int32 for PtypInteger32 and []string for PtypMultipleString.
+type PropertyBuilder struct {
+	values []*builderValue
+}
+
+// Set a property by its name, eg. PidTagSubject, PidLidAppointmentStartWhole or PidNameKeywords.
+func (b *PropertyBuilder) Set(name string, value interface{}) (err error) {
+	p := GetPropertyByName(name)
+	switch {
+	case p == nil:
+		return ErrPropertyNotFound
+	case strings.HasPrefix(p.Name, "PidLid"):
+		return b.SetNumeric(p.Guid(), uint32(p.ID), p.Type, value)
+	case strings.HasPrefix(p.Name, "PidName"):
+		return b.SetString(p.Guid(), p.StringName, p.Type, value)
+	}
+	return b.SetTag(p.ID, p.Type, value)
+}
+
+// SetTag set a property by ID and type.
+func (b *PropertyBuilder) SetTag(id PropertyID, propType PropertyType, value interface{}) (err error) {
+	if id >= namedPropertyMin {
+		return ErrPropertyID
+	}
+	return b.set(&builderValue{id: id, propType: propType}, value)
+}
+
+// SetNumeric set a numeric named property, eg. PidLidAppointmentStartWhole is 0x820D in PSETID_Appointment.
+func (b *PropertyBuilder) SetNumeric(guid cfb.GUID, lid uint32, propType PropertyType, value interface{}) (err error) {
+	return b.set(&builderValue{named: &NamedProperty{GUID: guid, LID: lid}, propType: propType}, value)
+}
+
+// SetString set a string named property, eg. "X-Mailer" in PS_INTERNET_HEADERS.
+func (b *PropertyBuilder) SetString(guid cfb.GUID, name string, propType PropertyType, value interface{}) (err error) {
+	return b.set(&builderValue{named: &NamedProperty{GUID: guid, Name: name}, propType: propType}, value)
+}
+
+func (b *PropertyBuilder) set(v *builderValue, value interface{}) (err error) {
+	if v.data, v.values, err = encodeValue(v.propType, value); err != nil {
+		return
+	}
+
+	for i, old := range b.values {
+		if old.id == v.id && old.propType == v.propType && sameName(old.named, v.named) {
+			b.values[i] = v
+			return
+		}
+	}
+	b.values = append(b.values, v)
+	return
+}
+
+func sameName(a, b *NamedProperty) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.GUID == b.GUID && a.LID == b.LID && a.Name == b.Name
+}
+
+// MessageBuilder build a message file, or a message embedded in an attachment.
+type MessageBuilder struct {
+	PropertyBuilder
+	recipients  []*PropertyBuilder
+	attachments []*attachmentBuilder
+}
+
+type attachmentBuilder struct {
+	properties *PropertyBuilder
+	message    *MessageBuilder // Embedded message, nil if by value
+}
+
+// NewMessageBuilder create a builder of a Unicode message of class IPM.Note.
+func NewMessageBuilder() (b *MessageBuilder) {
+	b = new(MessageBuilder)
+	b.Set(PidTagMessageClass, "IPM.Note")
+	b.Set(PidTagStoreSupportMask, int32(STORE_UNICODE_OK))
+	b.Set(PidTagMessageFlags, int32(0x00000001)) // mfRead
+	return
+}
+
+// AddRecipient add an SMTP recipient, the returned builder may set further recipient properties.
+func (b *MessageBuilder) AddRecipient(recipientType RecipientType, displayName, address string) (r *PropertyBuilder) {
+	r = new(PropertyBuilder)
+	r.Set(PidTagRowid, int32(len(b.recipients)))
+	r.Set(PidTagRecipientType, int32(recipientType))
+	r.Set(PidTagDisplayName, displayName)
+	r.Set(PidTagRecipientDisplayName, displayName)
+	r.Set(PidTagAddressType, "SMTP")
+	r.Set(PidTagEmailAddress, address)
+	r.Set(PidTagSmtpAddress, address)
+	b.recipients = append(b.recipients, r)
+	return
+}
+
+// AddAttachment add an attachment by value, the returned builder may set further attachment properties, eg.
+// PidTagAttachContentId of inline images.
+func (b *MessageBuilder) AddAttachment(fileName, mimeType string, data []byte) (a *PropertyBuilder) {
+	a = b.addAttachment(fileName, AttachByValue, nil)
+	a.Set(PidTagAttachDataBinary, data)
+	a.Set(PidTagAttachSize, int32(len(data)))
+	if mimeType != "" {
+		a.Set(PidTagAttachMimeTag, mimeType)
+	}
+	return
+}
+
+// AddMessage add an embedded message attachment.
+func (b *MessageBuilder) AddMessage(fileName string, m *MessageBuilder) (a *PropertyBuilder) {
+	return b.addAttachment(fileName, AttachEmbeddedMessage, m)
+}
+
+func (b *MessageBuilder) addAttachment(fileName string, method AttachMethod, m *MessageBuilder) (a *PropertyBuilder) {
+	a = new(PropertyBuilder)
+	a.Set(PidTagAttachNumber, int32(len(b.attachments)))
+	a.Set(PidTagAttachMethod, int32(method))
+	a.Set(PidTagRenderingPosition, int32(-1)) // Not rendered
+	if fileName != "" {
+		a.Set(PidTagAttachLongFilename, fileName)
+		a.Set(PidTagAttachFilename, fileName)
+		a.Set(PidTagAttachExtension, path.Ext(fileName))
+		a.Set(PidTagDisplayName, fileName)
+	}
+	b.attachments = append(b.attachments, &attachmentBuilder{a, m})
+	return
+}
+
+// Document build the compound file of the message.
+func (b *MessageBuilder) Document() (d *cfb.Document, err error) {
+	if d, err = cfb.New(); err != nil {
+		return
+	}
+
+	root, err := d.Root()
+	if err != nil {
+		return
+	}
+	root.CLSID = CLSID_Message
+
+	names := new(nameTable)
+	if err = b.write(root, headerTopLevel, names); err != nil {
+		return
+	}
+	err = names.write(root)
+	return
+}
+
+// WriteTo write the message file.
+func (b *MessageBuilder) WriteTo(w io.Writer) (n int64, err error) {
+	d, err := b.Document()
+	if err != nil {
+		return
+	}
+	return d.WriteTo(w)
+}
+
+func (b *MessageBuilder) write(storage *cfb.DirectoryEntry, headerSize int, names *nameTable) (err error) {
+	header := make([]byte, headerSize)
+	byteOrder.PutUint32(header[8:], uint32(len(b.recipients)))
+	byteOrder.PutUint32(header[12:], uint32(len(b.attachments)))
+	byteOrder.PutUint32(header[16:], uint32(len(b.recipients)))
+	byteOrder.PutUint32(header[20:], uint32(len(b.attachments)))
+
+	for i, r := range b.recipients {
+		var s *cfb.DirectoryEntry
+		if s, err = storage.AddStorage(fmt.Sprintf("%s%08X", RecipientPrefix, i)); err != nil {
+			return
+		}
+		if err = r.write(s, make([]byte, headerObject), names, nil); err != nil {
+			return
+		}
+	}
+
+	dataObject := GetPropertyByName(PidTagAttachDataObject)
+	for i, a := range b.attachments {
+		var s *cfb.DirectoryEntry
+		if s, err = storage.AddStorage(fmt.Sprintf("%s%08X", AttachmentPrefix, i)); err != nil {
+			return
+		}
+
+		var extra []byte
+		if a.message != nil {
+			var object *cfb.DirectoryEntry
+			if object, err = s.AddStorage(fmt.Sprintf("%s%04X%04X", PropertyPrefix, dataObject.ID, dataObject.Type)); err != nil {
+				return
+			}
+			if err = a.message.write(object, headerEmbedded, names); err != nil {
+				return
+			}
+
+			// Size 0xFFFFFFFF and reserved 1 for an embedded message
+			extra = make([]byte, propertyEntrySize)
+			byteOrder.PutUint32(extra, uint32(dataObject.ID)<<16|uint32(dataObject.Type))
+			byteOrder.PutUint32(extra[4:], PROPATTR_READABLE|PROPATTR_WRITABLE)
+			byteOrder.PutUint32(extra[8:], 0xFFFFFFFF)
+			byteOrder.PutUint32(extra[12:], 1)
+		}
+		if err = a.properties.write(s, make([]byte, headerObject), names, extra); err != nil {
+			return
+		}
+	}
+	return b.PropertyBuilder.write(storage, header, names, nil)
+}
+
+// write the property streams and the properties stream, with the given header and extra property entries.
+func (b *PropertyBuilder) write(storage *cfb.DirectoryEntry, stream []byte, names *nameTable, extra []byte) (err error) {
+	for _, v := range b.values {
+		id := v.id
+		if v.named != nil {
+			id = names.id(v.named)
+		}
+
+		entry := make([]byte, propertyEntrySize)
+		byteOrder.PutUint32(entry, uint32(id)<<16|uint32(v.propType))
+		byteOrder.PutUint32(entry[4:], PROPATTR_READABLE|PROPATTR_WRITABLE)
+
+		if IsFixedType(v.propType) {
+			copy(entry[8:], v.data)
+			stream = append(stream, entry...)
+			continue
+		}
+
+		name := fmt.Sprintf("%s%04X%04X", PropertyPrefix, uint32(id), uint32(v.propType))
+		if _, err = storage.AddStream(name, v.data); err != nil {
+			return
+		}
+		for i, value := range v.values {
+			if _, err = storage.AddStream(fmt.Sprintf("%s-%08X", name, i), value); err != nil {
+				return
+			}
+		}
+
+		byteOrder.PutUint32(entry[8:], uint32(len(v.data)))
+		stream = append(stream, entry...)
+	}
+
+	stream = append(stream, extra...)
+	_, err = storage.AddStream(PropertiesStreamName, stream)
+	return
+}
+
+// encodeValue encode a value of the type, as inline value or stream, and value streams of multiple valued strings and
+// binaries.
+func encodeValue(propType PropertyType, value interface{}) (data []byte, values [][]byte, err error) {
+	switch propType {
+	case PtypMultipleString, PtypMultipleString8:
+		v, ok := value.([]string)
+		if !ok {
+			return nil, nil, ErrPropertyType
+		}
+		for _, s := range v {
+			b, _, _ := encodeValue(propType&^multipleFlag, s)
+			data = append(data, uint32le(uint32(len(b)))...)
+			values = append(values, b)
+		}
+		return
+	case PtypMultipleBinary:
+		v, ok := value.([][]byte)
+		if !ok {
+			return nil, nil, ErrPropertyType
+		}
+		for _, b := range v {
+			data = append(data, uint32le(uint32(len(b)))...)
+			data = append(data, 0, 0, 0, 0)
+			values = append(values, b)
+		}
+		return
+	}
+
+	if propType&multipleFlag != 0 {
+		return encodeMultiple(propType&^multipleFlag, value)
+	}
+
+	switch v := value.(type) {
+	case string:
+		switch propType {
+		case PtypString:
+			for _, c := range utf16.Encode([]rune(v)) {
+				data = append(data, byte(c), byte(c>>8))
+			}
+			data = append(data, 0, 0)
+		case PtypString8:
+			data = append([]byte(v), 0)
+		default:
+			err = ErrPropertyType
+		}
+	case []byte:
+		if propType != PtypBinary {
+			err = ErrPropertyType
+		}
+		data = v
+	case int16:
+		data, err = fixedValue(propType, PtypInteger16, uint64(uint16(v)))
+	case int32:
+		data, err = fixedValue(propType, PtypInteger32, uint64(uint32(v)))
+	case int64:
+		data, err = fixedValue(propType, PtypInteger64, uint64(v))
+	case float32:
+		data, err = fixedValue(propType, PtypFloating32, uint64(math.Float32bits(v)))
+	case float64:
+		data, err = fixedValue(propType, PtypFloating64, math.Float64bits(v))
+	case Currency:
+		data, err = fixedValue(propType, PtypCurrency, uint64(v))
+	case uint32:
+		data, err = fixedValue(propType, PtypErrorCode, uint64(v))
+	case bool:
+		var b uint64
+		if v {
+			b = 1
+		}
+		data, err = fixedValue(propType, PtypBoolean, b)
+	case time.Time:
+		if propType == PtypFloatingTime {
+			data = uint64le(math.Float64bits(oleDate(v)))
+		} else {
+			f := cfb.NewFILETIME(v)
+			data, err = fixedValue(propType, PtypTime, uint64(f.HighDateTime)<<32|uint64(f.LowDateTime))
+		}
+	case cfb.GUID:
+		if propType != PtypGuid {
+			err = ErrPropertyType
+		}
+		data = guidBytes(v)
+	default:
+		err = ErrPropertyType
+	}
+	return
+}
+
+func encodeMultiple(propType PropertyType, value interface{}) (data []byte, values [][]byte, err error) {
+	var items []interface{}
+	switch v := value.(type) {
+	case []int16:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	case []int32:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	case []int64:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	case []float32:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	case []float64:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	case []Currency:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	case []time.Time:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	case []cfb.GUID:
+		for _, x := range v {
+			items = append(items, x)
+		}
+	default:
+		return nil, nil, ErrPropertyType
+	}
+
+	size := fixedSize(propType)
+	for _, item := range items {
+		var b []byte
+		if b, _, err = encodeValue(propType, item); err != nil {
+			return
+		}
+		data = append(data, b[:size]...)
+	}
+	return
+}
+
+// fixedValue encode an inline value, if the value is of the property type.
+func fixedValue(propType, valueType PropertyType, v uint64) (data []byte, err error) {
+	if propType != valueType {
+		return nil, ErrPropertyType
+	}
+	return uint64le(v), nil
+}
+
+func uint32le(v uint32) []byte {
+	b := make([]byte, 4)
+	byteOrder.PutUint32(b, v)
+	return b
+}
+
+// oleDate return the OLE automation date of a time, see oleTime. Whole days are counted by date, as a Duration only
+// cover about 292 years.
+func oleDate(t time.Time) float64 {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	days := float64((day.Unix() - oleEpoch.Unix()) / (24 * 60 * 60))
+	frac := float64(t.Sub(day)) / float64(24*time.Hour)
+	if days < 0 {
+		return days - frac // The time of day count forward
+	}
+	return days + frac
+}
+
+func uint64le(v uint64) []byte {
+	b := make([]byte, 8)
+	byteOrder.PutUint64(b, v)
+	return b
+}
+
+func guidBytes(g cfb.GUID) []byte {
+	b := make([]byte, 16)
+	byteOrder.PutUint32(b, g.DataA)
+	byteOrder.PutUint16(b[4:], g.DataB)
+	byteOrder.PutUint16(b[6:], g.DataC)
+	copy(b[8:], g.DataD[:])
+	return b
+}
+
+// nameTable assign property IDs to the named properties written, shared by all messages of a file.
+type nameTable struct {
+	guids   []cfb.GUID
+	entries []*NamedProperty
+}
+
+func (t *nameTable) id(n *NamedProperty) PropertyID {
+	for _, e := range t.entries {
+		if sameName(e, n) {
+			return e.ID
+		}
+	}
+
+	e := &NamedProperty{namedPropertyMin + PropertyID(len(t.entries)), n.GUID, n.LID, n.Name}
+	t.entries = append(t.entries, e)
+	t.guidIndex(n.GUID)
+	return e.ID
+}
+
+// guidIndex return the GUID index, 1 and 2 for PS_MAPI and PS_PUBLIC_STRINGS, from 3 the GUID stream.
+func (t *nameTable) guidIndex(g cfb.GUID) uint32 {
+	switch g {
+	case PS_MAPI:
+		return 1
+	case PS_PUBLIC_STRINGS:
+		return 2
+	}
+
+	for i, guid := range t.guids {
+		if guid == g {
+			return uint32(i) + 3
+		}
+	}
+	t.guids = append(t.guids, g)
+	return uint32(len(t.guids)) + 2
+}
+
+// write the __nameid_version1.0 storage, with the GUID, entry and string streams and the hash buckets.
+func (t *nameTable) write(root *cfb.DirectoryEntry) (err error) {
+	storage, err := root.AddStorage(NameIDStorageName)
+	if err != nil {
+		return
+	}
+
+	var guids, entries, strs []byte
+	buckets := make(map[uint32][]byte)
+	for _, g := range t.guids {
+		guids = append(guids, guidBytes(g)...)
+	}
+
+	for _, e := range t.entries {
+		info := uint32(e.ID-namedPropertyMin)<<16 | t.guidIndex(e.GUID)<<1
+		key := e.LID
+		if e.Name != "" {
+			name, _, _ := encodeValue(PtypString, e.Name)
+			name = name[:len(name)-2] // Without the terminator
+
+			info |= 1
+			key = rtfCRC(name)
+			entries = append(entries, uint32le(uint32(len(strs)))...)
+			strs = append(strs, uint32le(uint32(len(name)))...)
+			strs = append(strs, name...)
+			for len(strs)%4 != 0 {
+				strs = append(strs, 0)
+			}
+		} else {
+			entries = append(entries, uint32le(e.LID)...)
+		}
+		entries = append(entries, uint32le(info)...)
+
+		bucket := 0x1000 + (key^(info&0xFFFF))%nameIDBucketCount
+		buckets[bucket] = append(buckets[bucket], uint32le(key)...)
+		buckets[bucket] = append(buckets[bucket], uint32le(info)...)
+	}
+
+	streams := []struct {
+		name string
+		data []byte
+	}{
+		{NameIDGuidStreamName, guids},
+		{NameIDEntryStreamName, entries},
+		{NameIDStringStreamName, strs},
+	}
+	for _, s := range streams {
+		if _, err = storage.AddStream(s.name, s.data); err != nil {
+			return
+		}
+	}
+
+	for bucket := uint32(0x1000); bucket < 0x1000+nameIDBucketCount; bucket++ {
+		if data, ok := buckets[bucket]; ok {
+			if _, err = storage.AddStream(fmt.Sprintf("%s%04X0102", PropertyPrefix, bucket), data); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
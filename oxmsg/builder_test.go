@@ -0,0 +1,154 @@
+package oxmsg
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+func TestMessageBuilder(t *testing.T) {
+	when := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	inner := NewMessageBuilder()
+	inner.Set(PidTagSubject, "Inner")
+	inner.Set(PidTagBody, "Inner body")
+	inner.SetString(PS_INTERNET_HEADERS, "X-Inner", PtypString, "yes")
+
+	b := NewMessageBuilder()
+	settings := []struct {
+		name  string
+		value interface{}
+	}{
+		{PidTagSubject, "Ticket #42 – ÆØÅ"},
+		{PidTagBody, "Hello"},
+		{PidTagClientSubmitTime, when},
+		{PidTagSenderName, "Support"},
+		{PidTagSenderSmtpAddress, "support@example.com"},
+		{PidTagImportance, int32(2)},
+		{PidNameKeywords, []string{"one", "two"}},
+		{PidNameContentClass, "urn:content-classes:message"},
+		{PidLidAppointmentStartWhole, when},
+	}
+	for testId, test := range settings {
+		if err := b.Set(test.name, test.value); err != nil {
+			t.Errorf("Test [%d]: Set [%s] unexpected error [%v]", testId, test.name, err)
+		}
+	}
+
+	if err := b.Set(PidTagSubject, int32(1)); err != ErrPropertyType {
+		t.Errorf("Expected [%v], got [%v]", ErrPropertyType, err)
+	}
+	b.SetNumeric(PSETID_Task, 0x8104, PtypTime, when) // PidLidTaskStartDate
+	b.SetString(PS_INTERNET_HEADERS, "X-Mailer", PtypString, "Builder")
+	b.SetTag(0x7F00, PtypFloatingTime, time.Date(4501, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	b.AddRecipient(RecipientTo, "Bob", "bob@example.com")
+	b.AddRecipient(RecipientCc, "Carol", "carol@example.com")
+	b.AddAttachment("notes.txt", "text/plain", []byte("Some notes")).Set(PidTagAttachContentId, "notes@example.com")
+	b.AddMessage("Inner.msg", inner)
+
+	var buf bytes.Buffer
+	if _, err := b.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	doc, err := cfb.Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	m := &Message{Document: doc}
+
+	if root, _ := doc.Root(); root.ClassID() != CLSID_Message {
+		t.Errorf("Expected class [%v], got [%v]", CLSID_Message, root.ClassID())
+	}
+	if m.Properties(); !m.isUnicode {
+		t.Errorf("Expected unicode message")
+	}
+
+	values := append(settings, []struct {
+		name  string
+		value interface{}
+	}{
+		{PidTagMessageClass, "IPM.Note"},
+		{PidLidTaskStartDate, when},
+		{"x-mailer", "Builder"},
+		{"7F00", time.Date(4501, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}...)
+	for testId, test := range values {
+		e := m.Get(test.name)
+		if len(e) != 1 {
+			t.Errorf("Test [%d]: Expected [1] entry of [%s], got [%d]", testId, test.name, len(e))
+			continue
+		}
+		if v, err := e[0].Value(); err != nil || !reflect.DeepEqual(v, test.value) {
+			t.Errorf("Test [%d]: Expected [%v], got [%v] error [%v]", testId, test.value, v, err)
+		}
+	}
+
+	written := make(map[string]bool)
+	for _, n := range m.NameMap() {
+		written[n.Name] = true
+	}
+	for testId, name := range []string{"Content-Class", "Keywords", "X-Mailer"} {
+		if !written[name] {
+			t.Errorf("Test [%d]: Expected string name [%s], got [%v]", testId, name, written)
+		}
+	}
+
+	recipients, _ := m.Recipients()
+	if len(recipients) != 2 || recipients[1].Type != RecipientCc || recipients[1].String() != "Carol <carol@example.com>" {
+		t.Errorf("Expected [2] recipients, got [%v]", recipients)
+	}
+
+	attachments, _ := m.Attachments()
+	if len(attachments) != 2 {
+		t.Fatalf("Expected [2] attachments, got [%d]", len(attachments))
+	}
+
+	a := attachments[0]
+	r, _ := a.Open()
+	data, _ := io.ReadAll(r)
+	if a.FileName != "notes.txt" || a.MimeType != "text/plain" || a.ContentID != "notes@example.com" || a.Size != 10 || string(data) != "Some notes" {
+		t.Errorf("Expected [notes.txt], got [%v] data [%s]", *a, data)
+	}
+
+	embedded, err := attachments[1].Message()
+	if err != nil {
+		t.Fatalf("Message: unexpected error [%v]", err)
+	}
+	if v := stringProperty(embedded.Properties(), PidTagSubject); v != "Inner" {
+		t.Errorf("Expected [Inner], got [%s]", v)
+	}
+	if e := embedded.Get("X-Inner"); len(e) != 1 {
+		t.Errorf("Expected named property in embedded message, got [%d]", len(e))
+	}
+
+	var eml bytes.Buffer
+	if err := m.WriteMIME(&eml); err != nil || !bytes.Contains(eml.Bytes(), []byte("Subject: Inner")) {
+		t.Errorf("WriteMIME: unexpected error [%v]", err)
+	}
+}
+
+func TestOLEDate(t *testing.T) {
+	tests := []struct {
+		when time.Time
+		days float64
+	}{
+		{time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(1899, 12, 31, 12, 0, 0, 0, time.UTC), 1.5},
+		{time.Date(1899, 12, 29, 6, 0, 0, 0, time.UTC), -1.25},
+		{time.Date(4501, 1, 1, 6, 0, 0, 0, time.UTC), 949998.25},
+	}
+	for testId, test := range tests {
+		if days := oleDate(test.when); days != test.days {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.days, days)
+		}
+		if when := oleTime(test.days); !when.Equal(test.when) {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.when, when)
+		}
+	}
+}
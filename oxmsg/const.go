@@ -10,7 +10,16 @@ type PropertyID uint32
 type PropertyType uint16
 
 const (
-	PropertyPrefix = "__substg1.0_"
+	PropertyPrefix   = "__substg1.0_"
+	AttachmentPrefix = "__attach_version1.0_#"
+	RecipientPrefix  = "__recip_version1.0_#"
+
+	PropertiesStreamName = "__properties_version1.0"
+
+	NameIDStorageName      = "__nameid_version1.0"
+	NameIDGuidStreamName   = "__substg1.0_00020102"
+	NameIDEntryStreamName  = "__substg1.0_00030102"
+	NameIDStringStreamName = "__substg1.0_00040102"
 
 	PtypBinary               PropertyType = 0x0102 // COUNT, 16-bit
 	PtypBoolean                           = 0x000B
@@ -46,11 +55,12 @@ const (
 	PtypUnspecified                       = 0x0000
 
 	// The "types" below are NOT from the specification, but added to encompas the "property set"
-	// These constants are used instead of ID and does not represent unique ID's but "set (membership) ID".
+	// These constants are used as Property.Set and does not represent unique ID's but "set (membership) ID".
 	PsetAddress              PropertyID = 0xFFFFFFFF // PSETID_Address
 	PsetAirSync                         = 0xFFFFFFFE // PSETID_AirSync
 	PsetAppointment                     = 0xFFFFFFFD // PSETID_Appointment
 	PsetAttachment                      = 0xFFFFFFEC // PSETID_Attachment
+	PsetCalendarAssistant               = 0xFFFFFFEE // PSETID_CalendarAssistant
 	PsetCommon                          = 0xFFFFFFFB // PSETID_Common
 	PsetInternetHeaders                 = 0xFFFFFFFA // PS_INTERNET_HEADERS
 	PsetLog                             = 0xFFFFFFF9 // PSETID_Log
@@ -65,6 +75,8 @@ const (
 	PsetUnifiedMessaging                = 0xFFFFFFF0 // PSETID_UnifiedMessaging
 	PsetXmlExtractedEntities            = 0xFFFFFFEF // PSETID_XmlExtractedEntities
 	PsetLAST                            = 0xFFFFFF00 // -- Marker for Pset constants
+
+	namedPropertyMin PropertyID = 0x8000 // Property IDs from here on are named, see NameMap
 )
 
 var (
@@ -85,6 +97,7 @@ var (
 	PSETID_Sharing              = cfb.GUID{0x00062040, 0x0000, 0x0000, [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
 	PSETID_XmlExtractedEntities = cfb.GUID{0x23239608, 0x685D, 0x4732, [8]byte{0x9C, 0x55, 0x4C, 0x95, 0xCB, 0x4E, 0x8E, 0x33}}
 	PSETID_Attachment           = cfb.GUID{0x96357F7F, 0x59E1, 0x47D0, [8]byte{0x99, 0xA7, 0x46, 0x51, 0x5C, 0x18, 0x3B, 0x54}}
+	PSETID_CalendarAssistant    = cfb.GUID{0x11000E07, 0xB51B, 0x40D6, [8]byte{0xAF, 0x21, 0xCA, 0xA8, 0x5E, 0xDA, 0xB1, 0xD0}}
 )
 
 var (
@@ -92,4 +105,16 @@ var (
 	ErrPropertyID               = errors.New("Not a property ID")
 	ErrPropertyNotFound         = errors.New("Property not found")
 	ErrPropertyIllegalInstances = errors.New("Property was expected to be defined only once")
+	ErrNotEmbedded              = errors.New("Attachment is not an embedded message")
+	ErrPropertyStream           = errors.New("Properties stream is truncated")
+	ErrPropertyType             = errors.New("Property type cannot be read as requested")
+	ErrNameID                   = errors.New("Named property mapping is damaged")
+	ErrRTFHeader                = errors.New("Invalid compressed RTF header")
+	ErrRTFData                  = errors.New("Compressed RTF is truncated")
+	ErrRTFCRC                   = errors.New("Compressed RTF CRC mismatch")
+	ErrNotEncapsulated          = errors.New("RTF does not encapsulate HTML or text")
+	ErrFieldNotFound            = errors.New("Header field not found")
+	ErrAuthResults              = errors.New("Malformed Authentication-Results field")
+	ErrTagList                  = errors.New("Malformed tag list")
+	ErrARCInstance              = errors.New("ARC field instance is missing or invalid")
 )
@@ -0,0 +1,238 @@
+package oxmsg
+
+import (
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/xianhammer/format/internal/codepage"
+)
+
+// RTF destinations, beside those marked by "\*", whose content is not text.
+var rtfDestinations = map[string]bool{
+	"fonttbl": true, "colortbl": true, "stylesheet": true, "info": true, "pict": true, "object": true,
+	"header": true, "footer": true, "headerl": true, "headerr": true, "footerl": true, "footerr": true,
+	"listtable": true, "listoverridetable": true, "rsidtbl": true, "pntext": true, "fldinst": true,
+}
+
+// RTF control words producing text.
+var rtfText = map[string]string{
+	"par": "\r\n", "line": "\r\n", "tab": "\t", "emdash": "—", "endash": "–", "bullet": "•",
+	"lquote": "‘", "rquote": "’", "ldblquote": "“", "rdblquote": "”",
+}
+
+type rtfGroup struct {
+	skip    bool // Destination not holding text
+	htmlrtf bool // RTF only content, between \htmlrtf and \htmlrtf0
+	uc      int  // Characters to skip after \uN
+}
+
+type rtfDecoder struct {
+	b        []byte
+	i        int
+	out      []byte // UTF-8
+	ansi     []byte // Pending bytes in the ANSI code page
+	codePage uint16
+	skipNext int  // Fallback characters left to skip after \uN
+	high     rune // Pending high surrogate of \uN, the low surrogate is the next \uN
+	fromHTML bool
+	fromText bool
+}
+
+// OriginalBody return the HTML or plain text body the RTF body encapsulate, see Decapsulate.
+func (m *Message) OriginalBody() (body string, isHTML bool, err error) {
+	rtf, err := m.RTFBody()
+	if err != nil {
+		return
+	}
+	return Decapsulate(rtf)
+}
+
+// Decapsulate recover the HTML or plain text encapsulated in RTF by \fromhtml1 or \fromtext, [MS-OXRTFEX].
+// RTF not encapsulating anything give ErrNotEncapsulated.
+func Decapsulate(rtf []byte) (body string, isHTML bool, err error) {
+	d := &rtfDecoder{b: rtf, codePage: 1252}
+	if err = d.decode(); err != nil {
+		return
+	}
+	return string(d.out), d.fromHTML, nil
+}
+
+func (d *rtfDecoder) decode() (err error) {
+	stack := []rtfGroup{{uc: 1}}
+	star := false // Last token was "\*", the group is an ignorable destination unless it is \htmltag
+
+	for d.i < len(d.b) {
+		g := &stack[len(stack)-1]
+		c := d.b[d.i]
+		d.i++
+
+		switch c {
+		case '{':
+			stack = append(stack, *g)
+			star = false
+			continue
+		case '}':
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			star = false
+			continue
+		case '\r', '\n':
+			continue
+		case '\\':
+		default:
+			d.char(g, c)
+			continue
+		}
+
+		if d.i >= len(d.b) {
+			break
+		}
+
+		c = d.b[d.i]
+		if !isLetter(c) {
+			d.i++
+			switch c {
+			case '*':
+				star = true
+			case '\'':
+				if d.i+2 <= len(d.b) {
+					if v, err := strconv.ParseUint(string(d.b[d.i:d.i+2]), 16, 8); err == nil {
+						d.char(g, byte(v))
+					}
+					d.i += 2
+				}
+			case '\\', '{', '}':
+				d.char(g, c)
+			case '~':
+				d.text(g, " ")
+			case '_':
+				d.text(g, "-")
+			case '\r', '\n':
+				d.text(g, "\r\n")
+			}
+			continue
+		}
+
+		word, param, hasParam := d.controlWord()
+		if star {
+			star = false
+			g.skip = word != "htmltag"
+			if !g.skip {
+				g.htmlrtf = false
+			}
+			continue
+		}
+
+		switch word {
+		case "fromhtml":
+			d.fromHTML = true
+		case "fromtext":
+			d.fromText = true
+		case "ansicpg":
+			d.codePage = uint16(param)
+		case "htmlrtf":
+			g.htmlrtf = !hasParam || param != 0
+		case "uc":
+			g.uc = param
+		case "u":
+			if param < 0 {
+				param += 0x10000
+			}
+			d.codeUnit(g, rune(param))
+			if !g.skip && !g.htmlrtf {
+				d.skipNext = g.uc
+			}
+		default:
+			if rtfDestinations[word] {
+				g.skip = true
+			} else if s, ok := rtfText[word]; ok {
+				d.text(g, s)
+			}
+		}
+	}
+
+	if !d.fromHTML && !d.fromText {
+		return ErrNotEncapsulated
+	}
+	d.flush()
+	return
+}
+
+// controlWord read a control word and its optional parameter, consuming a delimiting space.
+func (d *rtfDecoder) controlWord() (word string, param int, hasParam bool) {
+	start := d.i
+	for d.i < len(d.b) && isLetter(d.b[d.i]) {
+		d.i++
+	}
+	word = string(d.b[start:d.i])
+
+	start = d.i
+	if d.i < len(d.b) && d.b[d.i] == '-' {
+		d.i++
+	}
+	for d.i < len(d.b) && '0' <= d.b[d.i] && d.b[d.i] <= '9' {
+		d.i++
+	}
+	if d.i > start {
+		param, _ = strconv.Atoi(string(d.b[start:d.i]))
+		hasParam = true
+	}
+
+	if d.i < len(d.b) && d.b[d.i] == ' ' {
+		d.i++
+	}
+	return
+}
+
+// char output a byte in the ANSI code page.
+func (d *rtfDecoder) char(g *rtfGroup, c byte) {
+	if d.skipNext > 0 {
+		d.skipNext--
+		return
+	}
+	if !g.skip && !g.htmlrtf {
+		d.ansi = append(d.ansi, c)
+	}
+}
+
+// codeUnit output the UTF-16 code unit of \uN, combining a surrogate pair, eg. "\u-10179?\u-8704?" is U+1F600.
+func (d *rtfDecoder) codeUnit(g *rtfGroup, r rune) {
+	if d.high != 0 {
+		pair := utf16.DecodeRune(d.high, r)
+		d.high = 0
+		if pair != utf8.RuneError {
+			d.text(g, string(pair))
+			return
+		}
+		d.text(g, string(utf8.RuneError)) // Unpaired high surrogate
+	}
+
+	if utf16.IsSurrogate(r) && r < 0xDC00 {
+		d.high = r
+		return
+	}
+	d.text(g, string(r))
+}
+
+// text output UTF-8 text.
+func (d *rtfDecoder) text(g *rtfGroup, s string) {
+	if !g.skip && !g.htmlrtf {
+		d.flush()
+		d.out = append(d.out, s...)
+	}
+}
+
+func (d *rtfDecoder) flush() {
+	if len(d.ansi) == 0 {
+		return
+	}
+
+	d.out = append(d.out, codepage.Decode(d.ansi, d.codePage)...)
+	d.ansi = d.ansi[:0]
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
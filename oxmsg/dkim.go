@@ -0,0 +1,228 @@
+package oxmsg
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TagList is a tag=value list of DKIM and ARC fields, RFC 6376 section 3.2. Whitespace around values is removed.
+type TagList map[string]string
+
+// ParseTagList parse a tag=value list. Only the first of repeated tags is kept.
+func ParseTagList(value string) (tags TagList, err error) {
+	tags = make(TagList)
+	for _, spec := range strings.Split(value, ";") {
+		if strings.TrimSpace(spec) == "" {
+			continue // Trailing semicolon
+		}
+
+		s := strings.SplitN(spec, "=", 2)
+		name := strings.TrimSpace(s[0])
+		if len(s) != 2 || name == "" || strings.ContainsAny(name, " \t\r\n") {
+			return nil, ErrTagList
+		}
+		if _, ok := tags[name]; !ok {
+			tags[name] = strings.TrimSpace(s[1])
+		}
+	}
+	return
+}
+
+// Signature is a DKIM-Signature (RFC 6376), ARC-Message-Signature or ARC-Seal (RFC 8617) field.
+type Signature struct {
+	Instance         int    // i= of ARC fields, 0 for DKIM-Signature
+	Version          int    // v=, not used by ARC-Seal
+	Algorithm        string // a=, eg. "rsa-sha256"
+	Domain           string // d=, the signing domain
+	Selector         string // s=
+	Canonicalization string // c=, eg. "relaxed/relaxed"
+	Query            string // q=
+	Headers          []string
+	Identity         string // i= of DKIM-Signature, the agent or user identifier
+	BodyHash         string // bh=, base64 with whitespace removed
+	Data             string // b=, base64 with whitespace removed
+	BodyLength       int64  // l=, -1 if not given
+	Timestamp        time.Time
+	Expiration       time.Time
+	ChainValidation  string // cv= of ARC-Seal, eg. "none", "pass" or "fail"
+	Tags             TagList
+}
+
+// ParseSignature parse the value of a DKIM-Signature field.
+func ParseSignature(value string) (*Signature, error) {
+	return parseSignature(value, false)
+}
+
+// ParseARCSignature parse the value of an ARC-Message-Signature or ARC-Seal field.
+func ParseARCSignature(value string) (*Signature, error) {
+	return parseSignature(value, true)
+}
+
+func parseSignature(value string, arc bool) (s *Signature, err error) {
+	tags, err := ParseTagList(value)
+	if err != nil {
+		return
+	}
+
+	s = &Signature{
+		Algorithm:        strings.ToLower(tags["a"]),
+		Domain:           tags["d"],
+		Selector:         tags["s"],
+		Canonicalization: strings.ToLower(tags["c"]),
+		Query:            tags["q"],
+		BodyHash:         removeWhitespace(tags["bh"]),
+		Data:             removeWhitespace(tags["b"]),
+		BodyLength:       -1,
+		ChainValidation:  strings.ToLower(tags["cv"]),
+		Tags:             tags,
+	}
+
+	if v, ok := tags["v"]; ok {
+		if s.Version, err = strconv.Atoi(v); err != nil {
+			return nil, ErrTagList
+		}
+	}
+
+	if arc {
+		if s.Instance, err = strconv.Atoi(tags["i"]); err != nil || s.Instance < 1 {
+			return nil, ErrARCInstance
+		}
+	} else {
+		s.Identity = tags["i"]
+	}
+
+	if h := removeWhitespace(tags["h"]); h != "" {
+		s.Headers = strings.Split(h, ":")
+	}
+
+	if v, ok := tags["l"]; ok {
+		if s.BodyLength, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, ErrTagList
+		}
+	}
+
+	if s.Timestamp, err = unixTag(tags, "t"); err == nil {
+		s.Expiration, err = unixTag(tags, "x")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ARCSet is the three fields of one ARC instance, RFC 8617. A field of the set is nil if missing.
+type ARCSet struct {
+	Instance              int
+	Seal                  *Signature
+	MessageSignature      *Signature
+	AuthenticationResults *AuthenticationResults
+}
+
+// ParseARCAuthenticationResults parse the value of an ARC-Authentication-Results field, "i=1; " followed by
+// Authentication-Results.
+func ParseARCAuthenticationResults(value string) (instance int, a *AuthenticationResults, err error) {
+	s := strings.SplitN(value, ";", 2)
+	tag := strings.SplitN(s[0], "=", 2)
+	if len(s) != 2 || len(tag) != 2 || strings.TrimSpace(tag[0]) != "i" {
+		return 0, nil, ErrARCInstance
+	}
+
+	if instance, err = strconv.Atoi(strings.TrimSpace(tag[1])); err != nil || instance < 1 {
+		return 0, nil, ErrARCInstance
+	}
+
+	a, err = ParseAuthenticationResults(s[1])
+	return
+}
+
+// AuthenticationResults return the parsed Authentication-Results fields, the most recent first. Fields that cannot be
+// parsed are skipped, err is then the first parse error.
+func (h *Header) AuthenticationResults() (results []*AuthenticationResults, err error) {
+	for _, value := range h.Values("Authentication-Results") {
+		a, e := ParseAuthenticationResults(value)
+		if e != nil {
+			if err == nil {
+				err = e
+			}
+			continue
+		}
+		results = append(results, a)
+	}
+	return
+}
+
+// DKIMSignatures return the parsed DKIM-Signature fields, in the order of the header. Fields that cannot be parsed
+// are skipped, err is then the first parse error.
+func (h *Header) DKIMSignatures() (signatures []*Signature, err error) {
+	for _, value := range h.Values("DKIM-Signature") {
+		s, e := ParseSignature(value)
+		if e != nil {
+			if err == nil {
+				err = e
+			}
+			continue
+		}
+		signatures = append(signatures, s)
+	}
+	return
+}
+
+// ARC return the ARC sets ordered by instance, the first set added first. Fields that cannot be parsed are skipped,
+// err is then the first parse error.
+func (h *Header) ARC() (sets []*ARCSet, err error) {
+	byInstance := make(map[int]*ARCSet)
+	set := func(instance int) *ARCSet {
+		if byInstance[instance] == nil {
+			byInstance[instance] = &ARCSet{Instance: instance}
+			sets = append(sets, byInstance[instance])
+		}
+		return byInstance[instance]
+	}
+
+	for _, f := range h.fields {
+		var s *Signature
+		var e error
+		switch strings.ToLower(f.Name) {
+		case "arc-seal":
+			if s, e = ParseARCSignature(f.Value); e == nil {
+				set(s.Instance).Seal = s
+			}
+		case "arc-message-signature":
+			if s, e = ParseARCSignature(f.Value); e == nil {
+				set(s.Instance).MessageSignature = s
+			}
+		case "arc-authentication-results":
+			var instance int
+			var a *AuthenticationResults
+			if instance, a, e = ParseARCAuthenticationResults(f.Value); e == nil {
+				set(instance).AuthenticationResults = a
+			}
+		}
+		if e != nil && err == nil {
+			err = e
+		}
+	}
+
+	sort.Slice(sets, func(i, j int) bool { return sets[i].Instance < sets[j].Instance })
+	return
+}
+
+// unixTag return the time of a tag in seconds since the Unix epoch, the zero time if not given.
+func unixTag(tags TagList, name string) (t time.Time, err error) {
+	v, ok := tags[name]
+	if !ok {
+		return
+	}
+
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return t, ErrTagList
+	}
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+func removeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
@@ -1,25 +1,36 @@
 package oxmsg
 
 import (
+	"bytes"
+	"encoding/binary"
 	"io"
 	"math"
 
 	"github.com/xianhammer/format/cfb"
 )
 
+var byteOrder = binary.LittleEndian
+
 type Entry struct {
-	*cfb.DirectoryEntry
-	property        *Property
-	isKnown         bool
-	interpretedName string
+	*cfb.DirectoryEntry // Nil for fixed size properties, read from the properties stream
+	property            *Property
+	isKnown             bool
+	interpretedName     string
+	tag                 uint32 // Property tag as stored, ID and type
+	flags               uint32 // PROPATTR_* flags of the properties stream
+	value               []byte // Inline value of fixed size properties
 }
 
-func newEntry(d *cfb.DirectoryEntry) (e *Entry) {
+func newEntry(d *cfb.DirectoryEntry, names NameMap) (e *Entry) {
 	e = new(Entry)
 	e.DirectoryEntry = d
 
 	var err error
 	if e.property, e.isKnown, err = ParseProperty(d.Name()); err == nil {
+		e.tag = uint32(e.property.ID)<<16 | uint32(e.property.Type)
+		if e.property.ID >= namedPropertyMin {
+			e.property, e.isKnown = names.resolve(e.property.ID, e.property.Type)
+		}
 		e.interpretedName = e.property.Name
 	} else {
 		e.interpretedName = d.Name()
@@ -27,41 +38,89 @@ func newEntry(d *cfb.DirectoryEntry) (e *Entry) {
 	return
 }
 
+// newFixedEntry create an entry of a fixed size property, with its value from the properties stream.
+func newFixedEntry(id PropertyID, propType PropertyType, flags uint32, value []byte, names NameMap) (e *Entry) {
+	e = new(Entry)
+	e.property, e.isKnown = names.resolve(id, propType)
+	e.tag = uint32(id)<<16 | uint32(propType)
+	e.interpretedName = e.property.Name
+	e.flags = flags
+	e.value = value
+	return
+}
+
 func (e *Entry) Name() string {
 	return e.interpretedName
 }
 
+// Property return the property of the entry, nil if the entry is not a property stream (or storage).
+func (e *Entry) Property() *Property {
+	return e.property
+}
+
+// PropertyTag return the property tag as stored in the file, the property ID in the high 16 bits and the type in
+// the low. Named properties have IDs from 0x8000, see NameMap.
+func (e *Entry) PropertyTag() uint32 {
+	return e.tag
+}
+
+// Flags return the PROPATTR_* flags of the property, as given by the properties stream.
+func (e *Entry) Flags() uint32 {
+	return e.flags
+}
+
+// IsFixed return true if the value was read from the properties stream rather than a stream of its own.
+func (e *Entry) IsFixed() bool {
+	return e.DirectoryEntry == nil
+}
+
+// IsStorage return true if the entry is a storage, eg. the embedded message of PidTagAttachDataObject.
+func (e *Entry) IsStorage() bool {
+	return e.DirectoryEntry != nil && e.Type == cfb.STGTY_STORAGE
+}
+
+// Reader return a reader of the property value.
+func (e *Entry) Reader() (r io.Reader, err error) {
+	if e.DirectoryEntry == nil {
+		return bytes.NewReader(e.value), nil
+	}
+	return e.Stream()
+}
+
 func (e *Entry) Uint8() (v uint8, err error) {
-	s, err := e.Stream()
-	if err != nil {
-		return
+	b, err := e.read(1)
+	if err == nil {
+		v = b[0]
 	}
-	return s.ReadUint8()
+	return
 }
 
 func (e *Entry) Uint16() (v uint16, err error) {
-	s, err := e.Stream()
-	if err != nil {
-		return
+	b, err := e.read(2)
+	if err == nil {
+		v = byteOrder.Uint16(b)
 	}
-	return s.ReadUint16()
+	return
 }
 
 func (e *Entry) Uint32() (v uint32, err error) {
-	s, err := e.Stream()
-	if err != nil {
-		return
+	b, err := e.read(4)
+	if err == nil {
+		v = byteOrder.Uint32(b)
 	}
-	return s.ReadUint32()
+	return
 }
 
-func (e *Entry) Float32() (v float32, err error) {
-	s, err := e.Stream()
-	if err != nil {
-		return
+func (e *Entry) Uint64() (v uint64, err error) {
+	b, err := e.read(8)
+	if err == nil {
+		v = byteOrder.Uint64(b)
 	}
+	return
+}
 
-	bits, err := s.ReadUint32()
+func (e *Entry) Float32() (v float32, err error) {
+	bits, err := e.Uint32()
 	if err == nil {
 		v = math.Float32frombits(bits)
 	}
@@ -69,12 +128,7 @@ func (e *Entry) Float32() (v float32, err error) {
 }
 
 func (e *Entry) Float64() (v float64, err error) {
-	s, err := e.Stream()
-	if err != nil {
-		return
-	}
-
-	bits, err := s.ReadUint64()
+	bits, err := e.Uint64()
 	if err == nil {
 		v = math.Float64frombits(bits)
 	}
@@ -82,26 +136,30 @@ func (e *Entry) Float64() (v float64, err error) {
 }
 
 func (e *Entry) String() (v string, err error) {
+	if e.DirectoryEntry == nil {
+		return "", ErrPropertyType
+	}
+
 	s, err := e.Stream()
 	if err != nil {
 		return
 	}
 
-	if e.property.Type == PtypString {
+	if e.property != nil && e.property.Type == PtypString {
 		return s.ReadUnicode()
 	}
 	return s.ReadString()
 }
 
 func (e *Entry) TypedReader() (r io.Reader, err error) {
+	if e.DirectoryEntry == nil || e.property == nil {
+		return e.Reader()
+	}
+
 	stream, err := e.Stream()
-	if err != nil || e.property == nil {
+	if err != nil {
 		return nil, err
 	}
-	// if err != nil || e.property == nil {
-	// 	r = stream
-	// 	return
-	// }
 
 	switch e.property.Type {
 	case PtypString:
@@ -114,3 +172,17 @@ func (e *Entry) TypedReader() (r io.Reader, err error) {
 	}
 	return
 }
+
+// read the first size bytes of the value.
+func (e *Entry) read(size int) (b []byte, err error) {
+	r, err := e.Reader()
+	if err != nil {
+		return
+	}
+
+	b = make([]byte, size)
+	if _, err = io.ReadFull(r, b); err != nil {
+		b = nil
+	}
+	return
+}
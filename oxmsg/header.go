@@ -3,42 +3,275 @@ package oxmsg
 import (
 	"bufio"
 	"io"
+	"mime"
+	"net/mail"
 	"strings"
+	"time"
+
+	"github.com/xianhammer/format/internal/codepage"
 )
 
+var wordDecoder = &mime.WordDecoder{CharsetReader: codepage.CharsetReader}
+
+// Layouts tried when a date is not RFC 5322, eg. missing the day of week or with a fractional second.
+var dateLayouts = []string{
+	"2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05.999999999 -0700",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	"Mon Jan 2 15:04:05 2006",
+	"Mon Jan 2 15:04:05 -0700 2006",
+	"Mon Jan 2 15:04:05 MST 2006",
+	time.RFC3339Nano,
+}
+
+// Field is a header field. The value is unfolded, but encoded-words are not decoded, see Decoded.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Decoded return the value with RFC 2047 encoded-words decoded, the value as is if decoding fails.
+func (f Field) Decoded() string {
+	if s, err := wordDecoder.DecodeHeader(f.Value); err == nil {
+		return s
+	}
+	return f.Value
+}
+
+func (f Field) String() string {
+	return f.Name + ": " + f.Value
+}
+
+// Header is the RFC 5322 header of a message, usually PidTagTransportMessageHeaders. Entry is nil for headers read
+// by ReadHeader.
 type Header struct {
 	*Entry
+	fields []Field
 }
 
-func (h *Header) Received() (blocks []*Block, err error) {
-	var r io.Reader
-	if r, err = h.TypedReader(); err == nil {
-		blocks = h.readBlocks(r)
+// ReadHeader parse an RFC 5322 header, reading up to the first empty line.
+func ReadHeader(r io.Reader) (h *Header, err error) {
+	h = new(Header)
+	if h.fields, err = readFields(r); err != nil {
+		return nil, err
+	}
+	return
+}
 
-		// Re-order to first "received" first
-		l := len(blocks) - 1
-		for i := 0; i < l/2; i++ {
-			blocks[i], blocks[l-i] = blocks[l-i], blocks[i]
+// Fields return the fields in the order of the header.
+func (h *Header) Fields() []Field {
+	return h.fields
+}
+
+// Values return the raw values of all fields of the name, matched case insensitive.
+func (h *Header) Values(name string) (values []string) {
+	for _, f := range h.fields {
+		if strings.EqualFold(f.Name, name) {
+			values = append(values, f.Value)
 		}
 	}
 	return
 }
 
-func (h *Header) readBlocks(r io.Reader) (blocks []*Block) {
+// Get return the decoded value of the first field of the name, "" if not present.
+func (h *Header) Get(name string) string {
+	for _, f := range h.fields {
+		if strings.EqualFold(f.Name, name) {
+			return f.Decoded()
+		}
+	}
+	return ""
+}
+
+func (h *Header) Subject() string {
+	return h.Get("Subject")
+}
+
+func (h *Header) MessageID() string {
+	return strings.Trim(strings.TrimSpace(h.Get("Message-ID")), "<>")
+}
+
+// Date return the origination date of the Date field.
+func (h *Header) Date() (t time.Time, err error) {
+	values := h.Values("Date")
+	if values == nil {
+		return t, ErrFieldNotFound
+	}
+	return parseDate(values[0])
+}
+
+func (h *Header) From() ([]*mail.Address, error) {
+	return h.AddressList("From")
+}
+
+func (h *Header) To() ([]*mail.Address, error) {
+	return h.AddressList("To")
+}
+
+func (h *Header) Cc() ([]*mail.Address, error) {
+	return h.AddressList("Cc")
+}
+
+func (h *Header) ReplyTo() ([]*mail.Address, error) {
+	return h.AddressList("Reply-To")
+}
+
+// AddressList parse the addresses of all fields of the name, decoding encoded-words of display names. Groups are
+// flattened, eg. "undisclosed-recipients:;" is no addresses.
+func (h *Header) AddressList(name string) (addresses []*mail.Address, err error) {
+	values := h.Values(name)
+	if values == nil {
+		return nil, ErrFieldNotFound
+	}
+
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+	for _, value := range values {
+		if strings.TrimSpace(value) == "" || isEmptyGroup(value) {
+			continue
+		}
+
+		var list []*mail.Address
+		if list, err = parser.ParseList(value); err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, list...)
+	}
+	return
+}
+
+// readFields read header fields up to the first empty line. Folded lines are unfolded, lines that are not fields, eg.
+// "Microsoft Mail Internet Headers Version 2.0", are skipped along with their continuation lines.
+func readFields(r io.Reader) (fields []Field, err error) {
 	scanner := bufio.NewScanner(r)
-	split := bufio.ScanLines
-	scanner.Split(split)
+	scanner.Buffer(nil, 1<<20)
 
-	var block *Block
+	keep := false
 	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.HasPrefix(line, sPrefix) {
-			block = new(Block)
-			block.add(line, false)
-			blocks = append(blocks, block)
+		line := strings.TrimRight(scanner.Text(), "\r\x00")
+		if line == "" {
+			if len(fields) > 0 {
+				break // End of header
+			}
+		} else if line[0] == ' ' || line[0] == '\t' {
+			if keep {
+				fields[len(fields)-1].Value += line
+			}
 		} else {
-			block.add(line, rContinuedLine.MatchString(line))
+			var name, value string
+			if name, value, keep = splitField(line); keep {
+				fields = append(fields, Field{name, value})
+			}
+		}
+	}
+
+	for i := range fields {
+		fields[i].Value = strings.TrimSpace(fields[i].Value)
+	}
+	return fields, scanner.Err()
+}
+
+// splitField split a header line at the first colon. The name must be printable ASCII without spaces, whitespace
+// before the colon is allowed (RFC 5322 obsolete syntax).
+func splitField(line string) (name, value string, ok bool) {
+	i := strings.IndexByte(line, ':')
+	if i < 0 {
+		return "", line, false
+	}
+
+	name = strings.TrimRight(line[:i], " \t")
+	for j := 0; j < len(name); j++ {
+		if name[j] <= ' ' || name[j] > '~' {
+			return "", line, false
+		}
+	}
+	return name, line[i+1:], name != ""
+}
+
+// parseDate parse an RFC 5322 date, tolerating comments and some common deviations.
+func parseDate(s string) (t time.Time, err error) {
+	s, _ = stripComments(s)
+	s = TrimMultipleSpaces(strings.TrimSpace(s))
+	if t, err = mail.ParseDate(s); err == nil {
+		return
+	}
+
+	for _, layout := range dateLayouts {
+		if v, e := time.Parse(layout, s); e == nil {
+			return v, nil
 		}
 	}
 	return
 }
+
+// isEmptyGroup report whether the address list is a single group without members, eg. "undisclosed-recipients:;".
+func isEmptyGroup(s string) bool {
+	s, _ = stripComments(s)
+	s = strings.TrimSpace(s)
+	i := strings.IndexByte(s, ':')
+	return i > 0 && strings.TrimSpace(s[i+1:]) == ";" && !strings.ContainsAny(s[:i], "<@\"")
+}
+
+// stripComments remove the comments of a structured field value, respecting quoted strings, and return them.
+// Each comment is replaced by a space.
+func stripComments(s string) (value string, comments []string) {
+	var b, comment strings.Builder
+	depth, quoted := 0, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && (quoted || depth > 0):
+			if depth > 0 {
+				comment.WriteByte(s[i+1])
+			} else {
+				b.WriteString(s[i : i+2])
+			}
+			i++
+			continue
+		case quoted:
+			quoted = c != '"'
+		case c == '"' && depth == 0:
+			quoted = true
+		case c == '(':
+			if depth++; depth == 1 {
+				continue
+			}
+		case c == ')' && depth > 0:
+			if depth--; depth == 0 {
+				comments = append(comments, strings.TrimSpace(comment.String()))
+				comment.Reset()
+				b.WriteByte(' ')
+				continue
+			}
+		}
+
+		if depth > 0 {
+			comment.WriteByte(c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), comments
+}
+
+// splitStructured split a structured field value at sep, outside of quoted strings and comments.
+func splitStructured(s string, sep byte) (parts []string) {
+	start, depth, quoted := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+		case quoted:
+			quoted = c != '"'
+		case c == '"' && depth == 0:
+			quoted = true
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
@@ -0,0 +1,366 @@
+package oxmsg
+
+import (
+	"net"
+	"net/mail"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+const testHeader = "Microsoft Mail Internet Headers Version 2.0\r\n" +
+	"Received: from mx.example.net (mx.example.net [203.0.113.5])\r\n" +
+	"\tby mail.example.com with ESMTPS id abc123\r\n" +
+	"\tfor <bob@example.com>; Tue, 1 Mar 2022 10:00:02 +0000\r\n" +
+	"Received: from [192.168.1.20] (unknown [198.51.100.7])\r\n" +
+	" by mx.example.net; Tue, 1 Mar 2022 10:00:00 +0000 (UTC)\r\n" +
+	"Authentication-Results: mail.example.com;\r\n" +
+	"\tdkim=pass (2048-bit key) header.d=example.net header.s=sel1 header.b=AbCd;\r\n" +
+	"\tspf=pass (mail.example.com: domain of alice@example.net designates 203.0.113.5 as permitted sender)\r\n" +
+	"\t smtp.mailfrom=alice@example.net;\r\n" +
+	"\tdmarc=fail reason=\"policy; quarantine\" header.from=example.net\r\n" +
+	"DKIM-Signature: v=1; a=rsa-sha256; c=relaxed/relaxed; d=example.net; s=sel1;\r\n" +
+	"\tt=1646128800; x=1646733600; h=From:To:Subject:Date;\r\n" +
+	"\tbh=47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=;\r\n" +
+	"\tb=dzdVyOfAKCdLXdJOc9G2q8LoXSlEniSbav+yuU4zGeeruD00lszZ\r\n" +
+	"\t VoG4ZHRNiYzR\r\n" +
+	"From: =?iso-8859-1?q?J=F8rgen?= <jorgen@example.net>\r\n" +
+	"To: Bob <bob@example.com>, \"Smith, Carol\" <carol@example.com>\r\n" +
+	"Cc: undisclosed-recipients:;\r\n" +
+	"Reply-To: =?UTF-8?B?w4ZzYQ==?= <aesa@example.net>\r\n" +
+	"Subject: =?windows-1252?q?Price_=80_100?=\r\n" +
+	" and more\r\n" +
+	"Date: Tue, 1 Mar 2022 11:00:00 +0100 (CET)\r\n" +
+	"Message-ID: <id-1@example.net>\r\n" +
+	"\r\n" +
+	"Body: not a header field\r\n"
+
+func TestReadHeader(t *testing.T) {
+	h, err := ReadHeader(strings.NewReader(testHeader))
+	if err != nil {
+		t.Fatalf("ReadHeader: unexpected error [%v]", err)
+	}
+
+	if len(h.Fields()) != 11 {
+		t.Errorf("Expected [11] fields, got [%d]", len(h.Fields()))
+	}
+
+	fields := []struct {
+		name, value string
+	}{
+		{"subject", "Price € 100 and more"},
+		{"From", "Jørgen <jorgen@example.net>"},
+		{"Body", ""},
+		{"X-Missing", ""},
+	}
+	for testId, test := range fields {
+		if v := h.Get(test.name); v != test.value {
+			t.Errorf("Test [%d]: Expected [%s], got [%s]", testId, test.value, v)
+		}
+	}
+
+	if v := h.Values("Received")[1]; v != "from [192.168.1.20] (unknown [198.51.100.7]) by mx.example.net; Tue, 1 Mar 2022 10:00:00 +0000 (UTC)" {
+		t.Errorf("Expected unfolded Received, got [%s]", v)
+	}
+	if v := h.MessageID(); v != "id-1@example.net" {
+		t.Errorf("Expected [id-1@example.net], got [%s]", v)
+	}
+
+	if date, err := h.Date(); err != nil || !date.Equal(time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected [2022-03-01 10:00:00 UTC], got [%v] error [%v]", date, err)
+	}
+
+	addresses := []struct {
+		list      func() ([]*mail.Address, error)
+		addresses []string
+	}{
+		{h.From, []string{"Jørgen <jorgen@example.net>"}},
+		{h.To, []string{"Bob <bob@example.com>", "Smith, Carol <carol@example.com>"}},
+		{h.Cc, nil},
+		{h.ReplyTo, []string{"Æsa <aesa@example.net>"}},
+	}
+	for testId, test := range addresses {
+		list, err := test.list()
+		if err != nil {
+			t.Errorf("Test [%d]: unexpected error [%v]", testId, err)
+			continue
+		}
+
+		var got []string
+		for _, a := range list {
+			got = append(got, a.Name+" <"+a.Address+">")
+		}
+		if !reflect.DeepEqual(got, test.addresses) {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.addresses, got)
+		}
+	}
+
+	if _, err := h.AddressList("Bcc"); err != ErrFieldNotFound {
+		t.Errorf("Expected [%v], got [%v]", ErrFieldNotFound, err)
+	}
+
+	hops := h.Received()
+	if len(hops) != 2 || !strings.Contains(hops[0].Body(), "[192.168.1.20]") {
+		t.Fatalf("Expected [2] hops, first received first, got [%v]", hops)
+	}
+	if m := hops[1].Map(); len(m["Received"]) != 1 {
+		t.Errorf("Expected one Received value, got [%v]", m)
+	}
+}
+
+func TestBlockMap(t *testing.T) {
+	b := &Block{lines: []string{"Received: from a@example.com", "no colon 10.0.0.1"}}
+
+	m := b.Map()
+	if !reflect.DeepEqual(m, map[string][]string{"Received": {"from a@example.com"}, "": {"no colon 10.0.0.1"}}) {
+		t.Errorf("Expected lines by name, got [%v]", m)
+	}
+
+	var keys []string
+	b.ScanIP(func(key string, ip net.IP) { keys = append(keys, key+"="+ip.String()) })
+	b.ScanMail(func(key, mail string) { keys = append(keys, key+"="+mail) })
+	if !reflect.DeepEqual(keys, []string{"=10.0.0.1", "Received=a@example.com"}) {
+		t.Errorf("Expected [=10.0.0.1 Received=a@example.com], got [%v]", keys)
+	}
+}
+
+func TestAuthenticationResults(t *testing.T) {
+	h, _ := ReadHeader(strings.NewReader(testHeader))
+
+	results, err := h.AuthenticationResults()
+	if err != nil || len(results) != 1 {
+		t.Fatalf("Expected one result, got [%v] error [%v]", results, err)
+	}
+
+	a := results[0]
+	if a.ServID != "mail.example.com" || len(a.Results) != 3 {
+		t.Fatalf("Expected [mail.example.com] with [3] results, got [%s] with [%d]", a.ServID, len(a.Results))
+	}
+
+	tests := []AuthResult{
+		{"dkim", "pass", "", "2048-bit key", map[string]string{"header.d": "example.net", "header.s": "sel1", "header.b": "AbCd"}},
+		{"spf", "pass", "", "mail.example.com: domain of alice@example.net designates 203.0.113.5 as permitted sender", map[string]string{"smtp.mailfrom": "alice@example.net"}},
+		{"dmarc", "fail", "policy; quarantine", "", map[string]string{"header.from": "example.net"}},
+	}
+	for testId, test := range tests {
+		if r := a.Result(test.Method); !reflect.DeepEqual(*r, test) {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test, *r)
+		}
+	}
+
+	values := []struct {
+		value   string
+		servID  string
+		version int
+		results int
+		err     error
+	}{
+		{"example.org 1; none", "example.org", 1, 0, nil},
+		{"example.org; spf = softfail smtp.mailfrom = x@example.org", "example.org", 0, 1, nil},
+		{"example.org (comment); auth/1=pass smtp.auth=alice", "example.org", 0, 1, nil},
+		{"", "", 0, 0, ErrAuthResults},
+		{"example.org; spf", "", 0, 0, ErrAuthResults},
+		{"spf=pass (sender IP is 203.0.113.5) smtp.mailfrom=example.net; dkim=pass (signature was verified) " +
+			"header.d=example.net;dmarc=pass action=none header.from=example.net;compauth=pass reason=100", "", 0, 4, nil},
+	}
+	for testId, test := range values {
+		a, err := ParseAuthenticationResults(test.value)
+		if err != test.err {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.err, err)
+		} else if err == nil && (a.ServID != test.servID || a.Version != test.version || len(a.Results) != test.results) {
+			t.Errorf("Test [%d]: Expected [%s %d %d], got [%s %d %d]", testId, test.servID, test.version, test.results, a.ServID, a.Version, len(a.Results))
+		}
+	}
+	// One malformed field does not hide the others
+	h, _ = ReadHeader(strings.NewReader("Authentication-Results: example.org; spf\r\n" +
+		"Authentication-Results: example.org; dkim=pass header.d=example.org\r\n" +
+		"DKIM-Signature: v=x\r\nDKIM-Signature: v=1; d=example.org; s=sel\r\n" +
+		"ARC-Seal: i=x\r\nARC-Seal: i=1; cv=none; d=example.org\r\n"))
+	if results, err := h.AuthenticationResults(); err != ErrAuthResults || len(results) != 1 || results[0].Result("dkim") == nil {
+		t.Errorf("Expected [1] result and [%v], got [%v] error [%v]", ErrAuthResults, results, err)
+	}
+	if signatures, err := h.DKIMSignatures(); err != ErrTagList || len(signatures) != 1 {
+		t.Errorf("Expected [1] signature and [%v], got [%v] error [%v]", ErrTagList, signatures, err)
+	}
+	if sets, err := h.ARC(); err != ErrARCInstance || len(sets) != 1 || sets[0].Seal == nil {
+		t.Errorf("Expected [1] set and [%v], got [%v] error [%v]", ErrARCInstance, sets, err)
+	}
+}
+
+func TestDKIMSignatures(t *testing.T) {
+	h, _ := ReadHeader(strings.NewReader(testHeader))
+
+	signatures, err := h.DKIMSignatures()
+	if err != nil || len(signatures) != 1 {
+		t.Fatalf("Expected one signature, got [%v] error [%v]", signatures, err)
+	}
+
+	s := signatures[0]
+	tests := []struct {
+		got, expected interface{}
+	}{
+		{s.Version, 1},
+		{s.Algorithm, "rsa-sha256"},
+		{s.Domain, "example.net"},
+		{s.Selector, "sel1"},
+		{s.Canonicalization, "relaxed/relaxed"},
+		{s.Headers, []string{"From", "To", "Subject", "Date"}},
+		{s.BodyHash, "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+		{s.Data, "dzdVyOfAKCdLXdJOc9G2q8LoXSlEniSbav+yuU4zGeeruD00lszZVoG4ZHRNiYzR"},
+		{s.BodyLength, int64(-1)},
+		{s.Timestamp, time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{s.Expiration, time.Date(2022, 3, 8, 10, 0, 0, 0, time.UTC)},
+	}
+	for testId, test := range tests {
+		if !reflect.DeepEqual(test.got, test.expected) {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.expected, test.got)
+		}
+	}
+
+	for testId, value := range []string{"v=1; a", "v=x; d=example.net", "t=soon", "=1"} {
+		if _, err := ParseSignature(value); err != ErrTagList {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, ErrTagList, err)
+		}
+	}
+}
+
+func TestARC(t *testing.T) {
+	h, _ := ReadHeader(strings.NewReader("ARC-Seal: i=2; a=rsa-sha256; t=1646128800; cv=pass; d=example.com; s=arc; b=Zm9v\r\n" +
+		"ARC-Message-Signature: i=2; a=rsa-sha256; c=relaxed/relaxed; d=example.com; s=arc; h=from:to; bh=YmFy; b=YmF6\r\n" +
+		"ARC-Authentication-Results: i=2; mx.example.com; arc=pass (i=1); dkim=pass header.d=example.net\r\n" +
+		"ARC-Seal: i=1; a=rsa-sha256; cv=none; d=example.net; s=arc; b=cXV4\r\n" +
+		"ARC-Authentication-Results: i=1; mx.example.net; spf=pass smtp.mailfrom=example.net\r\n"))
+
+	sets, err := h.ARC()
+	if err != nil || len(sets) != 2 {
+		t.Fatalf("Expected two sets, got [%v] error [%v]", sets, err)
+	}
+
+	tests := []struct {
+		chainValidation string
+		signed          bool
+		method          string
+	}{
+		{"none", false, "spf"},
+		{"pass", true, "arc"},
+	}
+	for testId, test := range tests {
+		set := sets[testId]
+		if set.Instance != testId+1 || set.Seal == nil || set.Seal.ChainValidation != test.chainValidation {
+			t.Errorf("Test [%d]: Expected instance [%d] sealed with [%s], got [%v]", testId, testId+1, test.chainValidation, set)
+		}
+		if (set.MessageSignature != nil) != test.signed {
+			t.Errorf("Test [%d]: Expected signed [%v], got [%v]", testId, test.signed, set.MessageSignature)
+		}
+		if set.AuthenticationResults == nil || set.AuthenticationResults.Result(test.method) == nil {
+			t.Errorf("Test [%d]: Expected [%s] result, got [%v]", testId, test.method, set.AuthenticationResults)
+		}
+	}
+
+	if sets[1].MessageSignature.Identity != "" || !reflect.DeepEqual(sets[1].MessageSignature.Headers, []string{"from", "to"}) {
+		t.Errorf("Expected no identity and [from to], got [%v]", sets[1].MessageSignature)
+	}
+
+	for testId, value := range []string{"mx.example.com; spf=pass", "i=0; mx.example.com", "i=x; mx.example.com"} {
+		if _, _, err := ParseARCAuthenticationResults(value); err != ErrARCInstance {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, ErrARCInstance, err)
+		}
+	}
+}
+
+func TestMessageHeader(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x0037, PtypString), unicode("Subject"))
+
+	m := reopen(t, d)
+	if _, err := m.Header(); err != ErrPropertyNotFound {
+		t.Errorf("Expected [%v], got [%v]", ErrPropertyNotFound, err)
+	}
+
+	root.AddStream(stream(0x007D, PtypString), unicode(testHeader))
+	m = reopen(t, d)
+	h, err := m.Header()
+	if err != nil {
+		t.Fatalf("Header: unexpected error [%v]", err)
+	}
+	if h.Entry == nil || h.Subject() != "Price € 100 and more" {
+		t.Errorf("Expected [Price € 100 and more], got [%s]", h.Subject())
+	}
+}
+
+func TestReceived(t *testing.T) {
+	h, _ := ReadHeader(strings.NewReader("Received: from relay.example.org (relay.example.org [IPv6:2a00:1450::5]) by mx.example.net\r\n" +
+		"\twith SMTP; Tue, 1 Mar 2022 10:00:05 +0000\r\n" +
+		"Received: by 10.0.0.1 with HTTP; Tue, 1 Mar 2022 09:59:00 -0100\r\n" +
+		"Received: from unknown (HELO by) (8.8.8.8) via x25; no date\r\n" +
+		"Received: from [192.168.1.20] (unknown [198.51.100.7]) by mx.example.net; 1 Mar 2022 10:59:58 +0100\r\n" +
+		testHeader))
+
+	tests := []struct {
+		fromHost, byHost, with, id, recipient string
+		fromIPs, reserved                     int
+		time                                  time.Time
+		delay                                 time.Duration
+		outOfOrder                            bool
+	}{
+		{"[192.168.1.20]", "mx.example.net", "", "", "", 2, 2, time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC), 0, false},
+		{"mx.example.net", "mail.example.com", "ESMTPS", "abc123", "<bob@example.com>", 1, 1, time.Date(2022, 3, 1, 10, 0, 2, 0, time.UTC), 2 * time.Second, false},
+		{"[192.168.1.20]", "mx.example.net", "", "", "", 2, 2, time.Date(2022, 3, 1, 9, 59, 58, 0, time.UTC), -4 * time.Second, true},
+		{"unknown", "", "", "", "", 1, 0, time.Time{}, 0, false},
+		{"", "10.0.0.1", "HTTP", "", "", 0, 0, time.Date(2022, 3, 1, 10, 59, 0, 0, time.UTC), 59*time.Minute + 2*time.Second, false},
+		{"relay.example.org", "mx.example.net", "SMTP", "", "", 1, 0, time.Date(2022, 3, 1, 10, 0, 5, 0, time.UTC), -58*time.Minute - 55*time.Second, true},
+	}
+
+	hops := h.Received()
+	if len(hops) != len(tests) {
+		t.Fatalf("Expected [%d] hops, got [%d]", len(tests), len(hops))
+	}
+	for testId, test := range tests {
+		hop := hops[testId]
+		if hop.FromHost() != test.fromHost || hop.ByHost() != test.byHost || hop.With != test.with || hop.ID != test.id || hop.For != test.recipient {
+			t.Errorf("Test [%d]: Expected [%s %s %s %s %s], got [%s %s %s %s %s]", testId, test.fromHost, test.byHost, test.with, test.id, test.recipient,
+				hop.FromHost(), hop.ByHost(), hop.With, hop.ID, hop.For)
+		}
+		if len(hop.FromIPs) != test.fromIPs || len(hop.Reserved) != test.reserved {
+			t.Errorf("Test [%d]: Expected [%d] addresses, [%d] reserved, got [%v] [%v]", testId, test.fromIPs, test.reserved, hop.FromIPs, hop.Reserved)
+		}
+		if !hop.Time.Equal(test.time) || hop.Delay != test.delay || hop.OutOfOrder != test.outOfOrder {
+			t.Errorf("Test [%d]: Expected [%v %v %v], got [%v %v %v]", testId, test.time, test.delay, test.outOfOrder, hop.Time, hop.Delay, hop.OutOfOrder)
+		}
+	}
+
+	if hops[3].From != "unknown (HELO by) (8.8.8.8)" || hops[3].Via != "x25" {
+		t.Errorf("Expected comments kept in clauses, got [%s] [%s]", hops[3].From, hops[3].Via)
+	}
+}
+
+func TestIsReservedIP(t *testing.T) {
+	tests := []struct {
+		ip       string
+		reserved bool
+	}{
+		{"8.8.8.8", false},
+		{"10.1.2.3", true},
+		{"172.31.255.255", true},
+		{"172.32.0.1", false},
+		{"192.168.0.1", true},
+		{"100.64.0.1", true},
+		{"127.0.0.1", true},
+		{"169.254.1.1", true},
+		{"::ffff:192.168.0.1", true},
+		{"::1", true},
+		{"fe80::1", true},
+		{"fd00::1", true},
+		{"2001:db8::1", true},
+		{"2a00:1450::5", false},
+	}
+	for testId, test := range tests {
+		if v := IsReservedIP(net.ParseIP(test.ip)); v != test.reserved {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test.reserved, v)
+		}
+	}
+}
@@ -5,8 +5,10 @@ import "github.com/xianhammer/format/cfb"
 type Message struct {
 	*cfb.Document
 
+	storage    *cfb.DirectoryEntry // Embedded message storage, nil for the root
 	isUnicode  bool
 	properties map[string][]*Entry
+	names      NameMap
 }
 
 func New() *Message {
@@ -23,14 +25,20 @@ func NewFromFile(filename string) (m *Message, err error) {
 	return
 }
 
+// Properties return the properties of the message storage. Recipients, attachments and embedded messages
+// hold their own properties, see Recipients and Attachments.
 func (m *Message) Properties() map[string][]*Entry {
 	if m.properties == nil {
-		m.properties = make(map[string][]*Entry)
-		m.Document.Walk(func(de *cfb.DirectoryEntry) {
-			e := newEntry(de)
-			n := e.Name()
-			m.properties[n] = append(m.properties[n], e)
-		})
+		headerSize := headerTopLevel
+		if m.storage != nil {
+			headerSize = headerEmbedded
+		}
+
+		if storage, err := m.Storage(); err == nil {
+			m.properties = properties(storage, headerSize, m.NameMap())
+		} else {
+			m.properties = make(map[string][]*Entry)
+		}
 
 		if eStore := m.Get(PidTagStoreSupportMask); eStore != nil {
 			v, _ := eStore[0].Uint32()
@@ -41,13 +49,39 @@ func (m *Message) Properties() map[string][]*Entry {
 }
 
 func (m *Message) Walk(f func(d *cfb.DirectoryEntry)) {
-	m.Document.Walk(f)
+	if m.storage != nil {
+		m.storage.Walk(f)
+	} else {
+		m.Document.Walk(f)
+	}
 }
 
+// Storage return the storage holding the message, the root entry unless the message is embedded in an attachment.
+func (m *Message) Storage() (storage *cfb.DirectoryEntry, err error) {
+	if m.storage != nil {
+		return m.storage, nil
+	}
+	return m.Document.Root()
+}
+
+// Get return the entries of a property name, or of a string named property, eg. "X-Mailer".
 func (m *Message) Get(propertyID string) []*Entry {
-	return m.Properties()[propertyID]
+	return get(m.Properties(), m.NameMap(), propertyID)
 }
 
+// NameMap return the named property mapping of the message file, shared by all (embedded) messages of the file.
+// A damaged mapping is ignored, named properties are then left unresolved.
+func (m *Message) NameMap() NameMap {
+	if m.names == nil {
+		var err error
+		if m.names, err = ReadNameMap(m.Document); err != nil {
+			m.names = make(NameMap)
+		}
+	}
+	return m.names
+}
+
+// Header return the parsed transport headers, PidTagTransportMessageHeaders.
 func (m *Message) Header() (h *Header, err error) {
 	entries := m.Get(PidTagTransportMessageHeaders)
 	if entries == nil {
@@ -57,6 +91,14 @@ func (m *Message) Header() (h *Header, err error) {
 		return nil, ErrPropertyIllegalInstances
 	}
 
-	h = &Header{entries[0]}
-	return
+	r, err := entries[0].TypedReader()
+	if err != nil {
+		return
+	}
+
+	fields, err := readFields(r)
+	if err != nil {
+		return
+	}
+	return &Header{entries[0], fields}, nil
 }
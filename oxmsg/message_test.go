@@ -0,0 +1,428 @@
+package oxmsg
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+func unicode(s string) (b []byte) {
+	for _, c := range utf16.Encode([]rune(s)) {
+		b = append(b, byte(c), byte(c>>8))
+	}
+	return
+}
+
+// stream name a property stream, eg. stream(0x3707, PtypString) is "__substg1.0_3707001F".
+func stream(id PropertyID, propType PropertyType) string {
+	return fmt.Sprintf("%s%04X%04X", PropertyPrefix, id, propType)
+}
+
+type fixed struct {
+	id       PropertyID
+	propType PropertyType
+	value    uint64
+}
+
+// propertyStream create a properties stream of fixed size values.
+func propertyStream(headerSize int, values ...fixed) []byte {
+	b := make([]byte, headerSize, headerSize+len(values)*propertyEntrySize)
+	for _, v := range values {
+		entry := make([]byte, propertyEntrySize)
+		byteOrder.PutUint32(entry, uint32(v.id)<<16|uint32(v.propType))
+		byteOrder.PutUint32(entry[4:], PROPATTR_READABLE|PROPATTR_WRITABLE)
+		byteOrder.PutUint64(entry[8:], v.value)
+		b = append(b, entry...)
+	}
+	return b
+}
+
+// reopen write the document and read it back as a message.
+func reopen(t *testing.T, d *cfb.Document) *Message {
+	var b bytes.Buffer
+	if _, err := d.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo: unexpected error [%v]", err)
+	}
+
+	doc, err := cfb.Open(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("Open: unexpected error [%v]", err)
+	}
+	return &Message{Document: doc}
+}
+
+func TestAttachments(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x0037, PtypString), unicode("Outer"))
+
+	data := bytes.Repeat([]byte("%PDF-1.4 "), 100)
+	a0, _ := root.AddStorage(AttachmentPrefix + "00000000")
+	a0.AddStream(stream(0x3707, PtypString), unicode("report.pdf"))
+	a0.AddStream(stream(0x3704, PtypString8), []byte("REPORT.PDF"))
+	a0.AddStream(stream(0x370E, PtypString), unicode("application/pdf"))
+	a0.AddStream(stream(0x3701, PtypBinary), data)
+	a0.AddStream(PropertiesStreamName, propertyStream(headerObject, fixed{0x3705, PtypInteger32, 1}, fixed{0x0E20, PtypInteger32, 1234}))
+
+	a1, _ := root.AddStorage(AttachmentPrefix + "00000001")
+	a1.AddStream(stream(0x3704, PtypString8), []byte("image.png"))
+	a1.AddStream(stream(0x3712, PtypString), unicode("image001@example.com"))
+	a1.AddStream(stream(0x3701, PtypBinary), []byte("\x89PNG"))
+
+	a2, _ := root.AddStorage(AttachmentPrefix + "00000002")
+	a2.AddStream(stream(0x3001, PtypString), unicode("Inner"))
+	inner, _ := a2.AddStorage(stream(0x3701, PtypObject))
+	inner.AddStream(PropertiesStreamName, propertyStream(headerEmbedded))
+	inner.AddStream(stream(0x0037, PtypString), unicode("Inner subject"))
+
+	m := reopen(t, d)
+	attachments, err := m.Attachments()
+	if err != nil || len(attachments) != 3 {
+		t.Fatalf("Expected [3] attachments, got [%d] error [%v]", len(attachments), err)
+	}
+
+	tests := []struct {
+		fileName, mimeType, contentID string
+		size                          int64
+		method                        AttachMethod
+		data                          []byte
+	}{
+		{"report.pdf", "application/pdf", "", 1234, AttachByValue, data},
+		{"image.png", "", "image001@example.com", 4, AttachByValue, []byte("\x89PNG")},
+		{"", "", "", 0, AttachEmbeddedMessage, nil},
+	}
+
+	for testId, test := range tests {
+		a := attachments[testId]
+		if a.FileName != test.fileName || a.MimeType != test.mimeType || a.ContentID != test.contentID || a.Size != test.size || a.Method != test.method {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test, *a)
+		}
+
+		r, err := a.Open()
+		if test.data == nil {
+			if err != ErrPropertyNotFound {
+				t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, ErrPropertyNotFound, err)
+			}
+			continue
+		}
+
+		got, err := io.ReadAll(r)
+		if err != nil || !bytes.Equal(got, test.data) {
+			t.Errorf("Test [%d]: Expected [%d] bytes, got [%d] bytes, error [%v]", testId, len(test.data), len(got), err)
+		}
+		if _, err := a.Message(); err != ErrNotEmbedded {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, ErrNotEmbedded, err)
+		}
+	}
+
+	embedded, err := attachments[2].Message()
+	if err != nil {
+		t.Fatalf("Message: unexpected error [%v]", err)
+	}
+
+	subjects := embedded.Get(PidTagSubject)
+	if len(subjects) != 1 {
+		t.Fatalf("Expected [1] subject, got [%d]", len(subjects))
+	}
+	if v, _ := subjects[0].String(); v != "Inner subject" {
+		t.Errorf("Expected [Inner subject], got [%s]", v)
+	}
+}
+
+func TestRecipients(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x3001, PtypString), unicode("Message"))
+
+	tests := []struct {
+		recipientType                                       RecipientType
+		displayName, smtpAddress, emailAddress, addressType string
+		address, text                                       string
+	}{
+		{RecipientTo, "Alice", "alice@example.com", "/O=EXAMPLE/CN=ALICE", "EX", "alice@example.com", "Alice <alice@example.com>"},
+		{RecipientCc, "bob@example.com", "", "bob@example.com", "SMTP", "bob@example.com", "bob@example.com"},
+		{RecipientBcc | 0x10000000, "Carol", "", "/O=EXAMPLE/CN=CAROL", "EX", "", "Carol"},
+	}
+
+	for testId, test := range tests {
+		r, _ := root.AddStorage(fmt.Sprintf("%s%08X", RecipientPrefix, testId))
+		r.AddStream(PropertiesStreamName, propertyStream(headerObject, fixed{0x0C15, PtypInteger32, uint64(test.recipientType)}))
+		r.AddStream(stream(0x3001, PtypString), unicode(test.displayName))
+		r.AddStream(stream(0x3003, PtypString), unicode(test.emailAddress))
+		r.AddStream(stream(0x3002, PtypString8), []byte(test.addressType))
+		if test.smtpAddress != "" {
+			r.AddStream(stream(0x39FE, PtypString), unicode(test.smtpAddress))
+		}
+	}
+
+	m := reopen(t, d)
+	recipients, err := m.Recipients()
+	if err != nil || len(recipients) != len(tests) {
+		t.Fatalf("Expected [%d] recipients, got [%d] error [%v]", len(tests), len(recipients), err)
+	}
+
+	for testId, test := range tests {
+		r := recipients[testId]
+		if r.Type != test.recipientType&recipientTypeMask || r.DisplayName != test.displayName || r.SmtpAddress != test.smtpAddress || r.EmailAddress != test.emailAddress || r.AddressType != test.addressType {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test, *r)
+		}
+		if r.Address() != test.address || r.String() != test.text {
+			t.Errorf("Test [%d]: Expected [%s], got [%s]", testId, test.text, r.String())
+		}
+	}
+
+	if names := m.Get(PidTagDisplayName); len(names) != 1 {
+		t.Errorf("Expected [1] display name, got [%d]", len(names))
+	} else if v, _ := names[0].String(); v != "Message" {
+		t.Errorf("Expected [Message], got [%s]", v)
+	}
+}
+
+func TestPropertyStream(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x0037, PtypString), unicode("Subject"))
+	root.AddStream(PropertiesStreamName, propertyStream(headerTopLevel,
+		fixed{0x340D, PtypInteger32, 0x00040000},
+		fixed{0x0E06, PtypTime, 0x01D0000000000000},
+		fixed{0x0E1F, PtypBoolean, 1},
+		fixed{0x0037, PtypString, 16},
+	))
+
+	m := reopen(t, d)
+	tests := []struct {
+		name  string
+		value uint64
+	}{
+		{PidTagStoreSupportMask, 0x00040000},
+		{PidTagMessageDeliveryTime, 0x01D0000000000000},
+		{PidTagRtfInSync, 1},
+	}
+
+	for testId, test := range tests {
+		entries := m.Get(test.name)
+		if len(entries) != 1 || !entries[0].IsFixed() {
+			t.Errorf("Test [%d]: Expected [1] fixed entry, got [%d]", testId, len(entries))
+			continue
+		}
+		if v, err := entries[0].Uint64(); err != nil || v != test.value {
+			t.Errorf("Test [%d]: Expected [%x], got [%x] error [%v]", testId, test.value, v, err)
+		}
+		if entries[0].Flags() != PROPATTR_READABLE|PROPATTR_WRITABLE {
+			t.Errorf("Test [%d]: Expected flags [6], got [%d]", testId, entries[0].Flags())
+		}
+	}
+
+	if !m.isUnicode {
+		t.Errorf("Expected unicode message")
+	}
+
+	subject := m.Get(PidTagSubject)
+	if len(subject) != 1 || subject[0].IsFixed() || subject[0].Flags() != PROPATTR_READABLE|PROPATTR_WRITABLE {
+		t.Errorf("Expected subject stream with flags, got [%d] entries", len(subject))
+	}
+
+	truncated, _ := cfb.New()
+	r, _ := truncated.Root()
+	r.AddStream(PropertiesStreamName, make([]byte, 10))
+	if err := readPropertyStream(r, headerTopLevel, map[string][]*Entry{}, nil); err != ErrPropertyStream {
+		t.Errorf("Expected [%v], got [%v]", ErrPropertyStream, err)
+	}
+}
+
+func TestNameMap(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	nameid, _ := root.AddStorage(NameIDStorageName)
+
+	var strs []byte
+	name := func(s string) uint32 {
+		offset := uint32(len(strs))
+		b := unicode(s)
+		strs = append(strs, byte(len(b)), 0, 0, 0)
+		strs = append(strs, b...)
+		for len(strs)%4 != 0 {
+			strs = append(strs, 0)
+		}
+		return offset
+	}
+
+	entries := []struct {
+		value, guidIndex, kind uint32
+	}{
+		{0x820D, 3, 0},               // 0x8000 PidLidAppointmentStartWhole
+		{name("x-mailer"), 4, 1},     // 0x8001
+		{name("Content-Type"), 4, 1}, // 0x8002 PidNameContentType
+		{0x9999, 5, 0},               // 0x8003 unknown
+		{0x0037, 1, 0},               // 0x8004 PS_MAPI, PidTagSubject
+		{0x8208, 5, 0},               // 0x8005 LID of PidLidLocation, but not in PSETID_Appointment
+		{name("Keywords"), 2, 1},     // 0x8006 PS_PUBLIC_STRINGS, PidNameKeywords
+		{name("keywords"), 4, 1},     // 0x8007 PS_INTERNET_HEADERS
+	}
+
+	var entryStream []byte
+	for i, e := range entries {
+		b := make([]byte, 8)
+		byteOrder.PutUint32(b, e.value)
+		byteOrder.PutUint32(b[4:], uint32(i)<<16|e.guidIndex<<1|e.kind)
+		entryStream = append(entryStream, b...)
+	}
+
+	guids := append(guidBytes(PSETID_Appointment), guidBytes(PS_INTERNET_HEADERS)...)
+	guids = append(guids, guidBytes(PSETID_Common)...)
+	nameid.AddStream(NameIDGuidStreamName, guids)
+	nameid.AddStream(NameIDEntryStreamName, entryStream)
+	nameid.AddStream(NameIDStringStreamName, strs)
+
+	root.AddStream(stream(0x8001, PtypString8), []byte("Mailer 1.0"))
+	root.AddStream(stream(0x8002, PtypString), unicode("text/plain"))
+	root.AddStream(stream(0x8003, PtypBinary), []byte{1, 2})
+	root.AddStream(stream(0x8004, PtypString), unicode("Subject"))
+	root.AddStream(stream(0x8005, PtypString), unicode("Room"))
+	root.AddStream(stream(0x8006, PtypString), unicode("Public"))
+	root.AddStream(stream(0x8007, PtypString), unicode("Header"))
+	root.AddStream(PropertiesStreamName, propertyStream(headerTopLevel, fixed{0x8000, PtypTime, 0x01D0000000000000}))
+
+	m := reopen(t, d)
+	names := m.NameMap()
+	if len(names) != len(entries) {
+		t.Fatalf("Expected [%d] names, got [%d]", len(entries), len(names))
+	}
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"x-mailer", "Mailer 1.0"},
+		{"X-Mailer", "Mailer 1.0"},
+		{PidNameContentType, "text/plain"},
+		{"content-type", "text/plain"},
+		{PidTagSubject, "Subject"},
+		{PSETID_Common.String() + ":0x9999", "\x01\x02"},
+		{PSETID_Common.String() + ":0x8208", "Room"},
+		{PidNameKeywords, "Public"},
+		{"Keywords", "Header"},
+	}
+
+	for testId, test := range tests {
+		e := m.Get(test.name)
+		if len(e) != 1 {
+			t.Errorf("Test [%d]: Expected [1] entry of [%s], got [%d]", testId, test.name, len(e))
+			continue
+		}
+		if v, _ := e[0].String(); v != test.value {
+			t.Errorf("Test [%d]: Expected [%s], got [%q]", testId, test.value, v)
+		}
+	}
+
+	if e := m.Get(PidLidLocation); len(e) != 0 {
+		t.Errorf("Expected [0] entries of [%s], got [%d]", PidLidLocation, len(e))
+	}
+
+	start := m.Get(PidLidAppointmentStartWhole)
+	if len(start) != 1 || start[0].PropertyTag() != 0x80000040 {
+		t.Fatalf("Expected [%s], got [%d] entries", PidLidAppointmentStartWhole, len(start))
+	}
+	if v, _ := start[0].Uint64(); v != 0x01D0000000000000 {
+		t.Errorf("Expected [%x], got [%x]", 0x01D0000000000000, v)
+	}
+}
+
+func TestValue(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+
+	when := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	filetime := cfb.NewFILETIME(when)
+	guid := guidBytes(PSETID_Common)
+
+	root.AddStream(PropertiesStreamName, propertyStream(headerTopLevel,
+		fixed{0x7F00, PtypInteger16, 0xFFFF},
+		fixed{0x7F01, PtypInteger32, 42},
+		fixed{0x7F02, PtypInteger64, 1 << 40},
+		fixed{0x7F03, PtypFloating64, math.Float64bits(2.5)},
+		fixed{0x7F04, PtypCurrency, 123456},
+		fixed{0x7F05, PtypFloatingTime, math.Float64bits(1.5)},
+		fixed{0x7F06, PtypTime, uint64(filetime.HighDateTime)<<32 | uint64(filetime.LowDateTime)},
+		fixed{0x7F07, PtypBoolean, 1},
+		fixed{0x7F08, PtypErrorCode, 0x80004005},
+		fixed{0x7F09, PtypFloating32, uint64(math.Float32bits(0.5))},
+		fixed{0x7F0A, PtypFloatingTime, math.Float64bits(949998.25)},
+		fixed{0x7F0B, PtypFloatingTime, math.Float64bits(-1.25)},
+	))
+
+	root.AddStream(stream(0x7F10, PtypGuid), guid)
+	root.AddStream(stream(0x7F11, PtypBinary), []byte{1, 2, 3})
+	root.AddStream(stream(0x7F12, PtypString), unicode("Unicode\x00"))
+	root.AddStream(stream(0x7F13, PtypString8), []byte("Ansi"))
+	root.AddStream(stream(0x7F14, PtypMultipleInteger32), []byte{1, 0, 0, 0, 2, 0, 0, 0})
+	root.AddStream(stream(0x7F15, PtypMultipleGuid), append(guid, guid...))
+
+	root.AddStream(stream(0x7F16, PtypMultipleString), []byte{10, 0, 0, 0, 6, 0, 0, 0})
+	root.AddStream(stream(0x7F16, PtypMultipleString)+"-00000000", unicode("One\x00"))
+	root.AddStream(stream(0x7F16, PtypMultipleString)+"-00000001", unicode("Two"))
+	root.AddStream(stream(0x7F17, PtypMultipleString8), []byte{3, 0, 0, 0})
+	root.AddStream(stream(0x7F17, PtypMultipleString8)+"-00000000", []byte("Abc"))
+	root.AddStream(stream(0x7F18, PtypMultipleBinary), make([]byte, 16))
+	root.AddStream(stream(0x7F18, PtypMultipleBinary)+"-00000000", []byte{1})
+	root.AddStream(stream(0x7F18, PtypMultipleBinary)+"-00000001", []byte{2, 3})
+	object, _ := root.AddStorage(stream(0x7F19, PtypObject))
+
+	m := reopen(t, d)
+	tests := []struct {
+		id     string
+		expect interface{}
+	}{
+		{"7F00", int16(-1)},
+		{"7F01", int32(42)},
+		{"7F02", int64(1 << 40)},
+		{"7F03", 2.5},
+		{"7F04", Currency(123456)},
+		{"7F05", time.Date(1899, 12, 31, 12, 0, 0, 0, time.UTC)},
+		{"7F06", when},
+		{"7F07", true},
+		{"7F08", uint32(0x80004005)},
+		{"7F09", float32(0.5)},
+		{"7F0A", time.Date(4501, 1, 1, 6, 0, 0, 0, time.UTC)},
+		{"7F0B", time.Date(1899, 12, 29, 6, 0, 0, 0, time.UTC)},
+		{"7F10", PSETID_Common},
+		{"7F11", []byte{1, 2, 3}},
+		{"7F12", "Unicode"},
+		{"7F13", "Ansi"},
+		{"7F14", []int32{1, 2}},
+		{"7F15", []cfb.GUID{PSETID_Common, PSETID_Common}},
+		{"7F16", []string{"One", "Two"}},
+		{"7F17", []string{"Abc"}},
+		{"7F18", [][]byte{{1}, {2, 3}}},
+	}
+
+	for testId, test := range tests {
+		e := m.Get(test.id)
+		if len(e) != 1 {
+			t.Errorf("Test [%d]: Expected [1] entry of [%s], got [%d]", testId, test.id, len(e))
+			continue
+		}
+
+		v, err := e[0].Value()
+		if err != nil || !reflect.DeepEqual(v, test.expect) {
+			t.Errorf("Test [%d]: Expected [%v], got [%v] error [%v]", testId, test.expect, v, err)
+		}
+	}
+
+	if v, err := m.Get("7F19")[0].Value(); err != nil || v.(*cfb.DirectoryEntry).Name() != object.Name() {
+		t.Errorf("Expected storage [%s], got [%v] error [%v]", object.Name(), v, err)
+	}
+	if len(m.Properties()) != len(tests)+2 {
+		t.Errorf("Expected [%d] properties, got [%d]", len(tests)+2, len(m.Properties()))
+	}
+	if s := Currency(-123456).String(); s != "-12.3456" {
+		t.Errorf("Expected [-12.3456], got [%s]", s)
+	}
+}
@@ -0,0 +1,399 @@
+package oxmsg
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/xianhammer/format/internal/codepage"
+)
+
+// Header fields of the transport headers replaced by the MIME structure written.
+var mimeStructureFields = map[string]bool{
+	"mime-version":              true,
+	"content-type":              true,
+	"content-transfer-encoding": true,
+	"content-disposition":       true,
+}
+
+type mimeField struct {
+	name, value string
+}
+
+// mimePart is a node of the MIME tree, either a leaf with an encoded body or a multipart.
+type mimePart struct {
+	header []mimeField
+	raw    []string // Header lines written as is, ie. the transport headers
+	body   []byte
+	parts  []*mimePart
+}
+
+// WriteMIME write the message as RFC 5322 / MIME, eg. an .eml file. The headers are those of
+// PidTagTransportMessageHeaders when present, otherwise synthesized from sender, recipients, subject and date. The
+// text and HTML bodies are alternatives, inline images are related to the HTML by content ID and embedded messages
+// are attached as message/rfc822.
+func (m *Message) WriteMIME(w io.Writer) (err error) {
+	root, err := m.mimeTree()
+	if err != nil {
+		return
+	}
+
+	bw := bufio.NewWriter(w)
+	if err = root.write(bw); err != nil {
+		return
+	}
+	return bw.Flush()
+}
+
+func (m *Message) mimeTree() (root *mimePart, err error) {
+	text, html := m.mimeBodies()
+
+	attachments, err := m.Attachments()
+	if err != nil {
+		return
+	}
+
+	var inline, attached []*mimePart
+	for _, a := range attachments {
+		var part *mimePart
+		if part, err = a.mimePart(m.string8CodePage()); err != nil {
+			return
+		}
+		if part == nil {
+			continue
+		}
+
+		if a.ContentID != "" && html != "" && strings.Contains(html, "cid:"+a.ContentID) {
+			part.set("Content-Disposition", strings.Replace(part.get("Content-Disposition"), "attachment", "inline", 1))
+			part.set("Content-ID", "<"+a.ContentID+">")
+			inline = append(inline, part)
+		} else {
+			attached = append(attached, part)
+		}
+	}
+
+	var alternatives []*mimePart
+	if text != "" || html == "" {
+		alternatives = append(alternatives, textPart("text/plain", text))
+	}
+	if html != "" {
+		htmlPart := textPart("text/html", html)
+		if len(inline) > 0 {
+			htmlPart = newMultipart("multipart/related", append([]*mimePart{htmlPart}, inline...))
+		}
+		alternatives = append(alternatives, htmlPart)
+	}
+
+	root = alternatives[0]
+	if len(alternatives) > 1 {
+		root = newMultipart("multipart/alternative", alternatives)
+	}
+	if len(attached) > 0 {
+		root = newMultipart("multipart/mixed", append([]*mimePart{root}, attached...))
+	}
+
+	root.raw = m.mimeHeader()
+	root.header = append([]mimeField{{"MIME-Version", "1.0"}}, root.header...)
+	return
+}
+
+// mimeBodies return the text and HTML bodies, falling back to what the RTF body encapsulate.
+func (m *Message) mimeBodies() (text, html string) {
+	text = m.text(PidTagBody)
+	html = m.text(PidTagBodyHtml)
+
+	if e := m.Get(PidTagHtml); html == "" && e != nil {
+		if r, err := e[0].Reader(); err == nil {
+			if b, err := io.ReadAll(r); err == nil {
+				html = codepage.Decode(bytes.TrimRight(b, "\x00"), m.codePage())
+			}
+		}
+	}
+
+	if text == "" && html == "" {
+		if body, isHTML, err := m.OriginalBody(); err == nil && isHTML {
+			html = body
+		} else if err == nil {
+			text = body
+		}
+	}
+	return
+}
+
+// codePage return the code page of the HTML body, PidTagInternetCodepage.
+func (m *Message) codePage() uint16 {
+	if e := m.Get(PidTagInternetCodepage); e != nil {
+		if v, err := e[0].Uint32(); err == nil {
+			return uint16(v)
+		}
+	}
+	return 1252
+}
+
+// string8CodePage return the code page of PtypString8 properties, PidTagMessageCodepage or else codePage.
+func (m *Message) string8CodePage() uint16 {
+	if e := m.Get(PidTagMessageCodepage); e != nil {
+		if v, err := e[0].Uint32(); err == nil {
+			return uint16(v)
+		}
+	}
+	return m.codePage()
+}
+
+// text return the first of the string properties present, see decodedString.
+func (m *Message) text(propertyIDs ...string) string {
+	return decodedString(m.Properties(), m.string8CodePage(), propertyIDs...)
+}
+
+// decodedString return the first of the string properties present as UTF-8, PtypString8 decoded from the code page.
+func decodedString(props map[string][]*Entry, codePage uint16, propertyIDs ...string) string {
+	for _, id := range propertyIDs {
+		if e := props[id]; e != nil {
+			if v, err := e[0].String(); err == nil {
+				if PropertyType(e[0].PropertyTag()) == PtypString8 {
+					v = codepage.Decode([]byte(v), codePage)
+				}
+				return strings.TrimRight(v, "\x00")
+			}
+		}
+	}
+	return ""
+}
+
+// mimeHeader return the transport headers, without the MIME structure fields, or synthesized headers.
+func (m *Message) mimeHeader() (lines []string) {
+	if transport := m.text(PidTagTransportMessageHeaders); transport != "" {
+		keep := false
+		for _, line := range strings.Split(strings.ReplaceAll(transport, "\r\n", "\n"), "\n") {
+			if line == "" {
+				if len(lines) > 0 {
+					break // End of header
+				}
+				continue
+			}
+
+			if line[0] != ' ' && line[0] != '\t' {
+				name := strings.ToLower(strings.TrimSpace(strings.SplitN(line, ":", 2)[0]))
+				keep = strings.Contains(line, ":") && !mimeStructureFields[name]
+			}
+			if keep {
+				lines = append(lines, line)
+			}
+		}
+		if len(lines) > 0 {
+			return
+		}
+	}
+
+	add := func(name, value string) {
+		if value != "" {
+			lines = append(lines, name+": "+value)
+		}
+	}
+
+	from := formatAddress(
+		m.text(PidTagSenderName, PidTagSentRepresentingName),
+		m.text(PidTagSenderSmtpAddress, PidTagSentRepresentingSmtpAddress),
+	)
+	if from == "" && strings.EqualFold(m.text(PidTagSenderAddressType), "SMTP") {
+		from = formatAddress(m.text(PidTagSenderName), m.text(PidTagSenderEmailAddress))
+	}
+	add("From", from)
+
+	if recipients, err := m.Recipients(); err == nil {
+		byType := map[RecipientType][]string{}
+		for _, r := range recipients {
+			name := decodedString(r.Properties(), m.string8CodePage(), PidTagDisplayName, PidTagRecipientDisplayName)
+			byType[r.Type] = append(byType[r.Type], formatAddress(name, r.Address()))
+		}
+		add("To", strings.Join(byType[RecipientTo], ", "))
+		add("Cc", strings.Join(byType[RecipientCc], ", "))
+		add("Bcc", strings.Join(byType[RecipientBcc], ", "))
+	}
+
+	add("Subject", mime.QEncoding.Encode("utf-8", m.text(PidTagSubject)))
+	for _, name := range []string{PidTagClientSubmitTime, PidTagMessageDeliveryTime} {
+		if e := m.Get(name); e != nil {
+			if v, err := e[0].Value(); err == nil {
+				if t, ok := v.(time.Time); ok {
+					add("Date", t.Format(time.RFC1123Z))
+					break
+				}
+			}
+		}
+	}
+	add("Message-ID", m.text(PidTagInternetMessageId))
+	add("In-Reply-To", m.text(PidTagInReplyToId))
+	add("References", m.text(PidTagInternetReferences))
+	return
+}
+
+// formatAddress format a mailbox, or an empty group if there is no address.
+func formatAddress(name, address string) string {
+	switch {
+	case address != "":
+		return (&mail.Address{Name: name, Address: address}).String()
+	case name != "":
+		return mime.QEncoding.Encode("utf-8", name) + ":;"
+	}
+	return ""
+}
+
+// mimePart return the part of an attachment, nil if it has no content. PtypString8 names are of the code page.
+func (a *Attachment) mimePart(codePage uint16) (part *mimePart, err error) {
+	fileName := decodedString(a.Properties(), codePage, PidTagAttachLongFilename, PidTagAttachFilename, PidTagDisplayName)
+
+	switch a.Method {
+	case AttachEmbeddedMessage:
+		var m *Message
+		if m, err = a.Message(); err != nil {
+			return
+		}
+
+		var b bytes.Buffer
+		if err = m.WriteMIME(&b); err != nil {
+			return
+		}
+		if fileName == "" {
+			fileName = m.text(PidTagSubject)
+		}
+		return leaf("message/rfc822", "", dispositionHeader(fileName+".eml"), b.Bytes()), nil
+	}
+
+	r, err := a.Open()
+	if err == ErrPropertyNotFound {
+		return nil, nil // By reference, or an OLE storage
+	} else if err != nil {
+		return
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+
+	contentType := a.MimeType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(fileName))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	if fileName != "" {
+		contentType = mime.FormatMediaType(contentType, map[string]string{"name": fileName})
+	}
+	return leaf(contentType, "base64", dispositionHeader(fileName), encodeBase64(b)), nil
+}
+
+func dispositionHeader(fileName string) string {
+	if fileName == "" {
+		return "attachment"
+	}
+	return mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+}
+
+func leaf(contentType, encoding, disposition string, body []byte) (part *mimePart) {
+	part = &mimePart{body: body}
+	part.header = append(part.header, mimeField{"Content-Type", contentType})
+	if disposition != "" {
+		part.header = append(part.header, mimeField{"Content-Disposition", disposition})
+	}
+	if encoding != "" {
+		part.header = append(part.header, mimeField{"Content-Transfer-Encoding", encoding})
+	}
+	return
+}
+
+func textPart(contentType, text string) *mimePart {
+	var b bytes.Buffer
+	w := quotedprintable.NewWriter(&b)
+	w.Write([]byte(text))
+	w.Close()
+
+	return leaf(contentType+"; charset=utf-8", "quoted-printable", "", b.Bytes())
+}
+
+func newMultipart(contentType string, parts []*mimePart) *mimePart {
+	return &mimePart{header: []mimeField{{"Content-Type", contentType}}, parts: parts}
+}
+
+func encodeBase64(b []byte) []byte {
+	const lineLength = 76
+
+	encoded := base64.StdEncoding.EncodeToString(b)
+	var out bytes.Buffer
+	for len(encoded) > lineLength {
+		out.WriteString(encoded[:lineLength])
+		out.WriteString("\r\n")
+		encoded = encoded[lineLength:]
+	}
+	out.WriteString(encoded)
+	return out.Bytes()
+}
+
+func (p *mimePart) write(w io.Writer) (err error) {
+	boundary := ""
+	if p.parts != nil {
+		boundary = multipart.NewWriter(io.Discard).Boundary()
+		p.set("Content-Type", fmt.Sprintf("%s; boundary=%q", p.get("Content-Type"), boundary))
+	}
+
+	for _, line := range p.raw {
+		if _, err = fmt.Fprintf(w, "%s\r\n", line); err != nil {
+			return
+		}
+	}
+	for _, f := range p.header {
+		if _, err = fmt.Fprintf(w, "%s: %s\r\n", f.name, f.value); err != nil {
+			return
+		}
+	}
+	if _, err = io.WriteString(w, "\r\n"); err != nil {
+		return
+	}
+
+	if p.parts == nil {
+		_, err = w.Write(p.body)
+		return
+	}
+
+	for _, part := range p.parts {
+		if _, err = fmt.Fprintf(w, "\r\n--%s\r\n", boundary); err != nil {
+			return
+		}
+		if err = part.write(w); err != nil {
+			return
+		}
+	}
+	_, err = fmt.Fprintf(w, "\r\n--%s--\r\n", boundary)
+	return
+}
+
+func (p *mimePart) get(name string) string {
+	for _, f := range p.header {
+		if f.name == name {
+			return f.value
+		}
+	}
+	return ""
+}
+
+// set replace the value of a header field, or add the field.
+func (p *mimePart) set(name, value string) {
+	for i := range p.header {
+		if p.header[i].name == name {
+			p.header[i].value = value
+			return
+		}
+	}
+	p.header = append(p.header, mimeField{name, value})
+}
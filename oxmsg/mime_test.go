@@ -0,0 +1,219 @@
+package oxmsg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+type mimeLeaf struct {
+	contentType string
+	body        string
+}
+
+// readParts flatten the leaves of a MIME entity, decoding base64 content.
+func readParts(t *testing.T, contentType string, header map[string][]string, body io.Reader) (leaves []mimeLeaf) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("ParseMediaType: unexpected error [%v]", err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		b, _ := io.ReadAll(body)
+		if len(header["Content-Transfer-Encoding"]) > 0 && header["Content-Transfer-Encoding"][0] == "base64" {
+			b, _ = base64.StdEncoding.DecodeString(strings.ReplaceAll(string(b), "\r\n", ""))
+		}
+		return []mimeLeaf{{mediaType, string(b)}}
+	}
+
+	leaves = append(leaves, mimeLeaf{mediaType, ""})
+	r := multipart.NewReader(body, params["boundary"])
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			return
+		} else if err != nil {
+			t.Fatalf("NextPart: unexpected error [%v]", err)
+		}
+		leaves = append(leaves, readParts(t, p.Header.Get("Content-Type"), p.Header, p)...)
+	}
+}
+
+func TestWriteMIME(t *testing.T) {
+	when := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	filetime := cfb.NewFILETIME(when)
+
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x0037, PtypString), unicode("Grüße"))
+	root.AddStream(stream(0x0C1A, PtypString), unicode("Alice"))
+	root.AddStream(stream(0x5D01, PtypString), unicode("alice@example.com"))
+	root.AddStream(stream(0x1000, PtypString), unicode("Hello"))
+	root.AddStream(stream(0x1013, PtypBinary), []byte(`<p>Hello <img src="cid:logo@example.com"></p>`))
+	root.AddStream(PropertiesStreamName, propertyStream(headerTopLevel,
+		fixed{0x0039, PtypTime, uint64(filetime.HighDateTime)<<32 | uint64(filetime.LowDateTime)}))
+
+	for i, to := range []struct {
+		name, address string
+		recipientType RecipientType
+	}{{"Bob", "bob@example.com", RecipientTo}, {"Carol", "carol@example.com", RecipientCc}} {
+		r, _ := root.AddStorage(RecipientPrefix + "0000000" + string(rune('0'+i)))
+		r.AddStream(stream(0x3001, PtypString), unicode(to.name))
+		r.AddStream(stream(0x39FE, PtypString), unicode(to.address))
+		r.AddStream(PropertiesStreamName, propertyStream(headerObject, fixed{0x0C15, PtypInteger32, uint64(to.recipientType)}))
+	}
+
+	a0, _ := root.AddStorage(AttachmentPrefix + "00000000")
+	a0.AddStream(stream(0x3707, PtypString), unicode("logo.png"))
+	a0.AddStream(stream(0x3712, PtypString), unicode("logo@example.com"))
+	a0.AddStream(stream(0x3701, PtypBinary), []byte("\x89PNG"))
+
+	a1, _ := root.AddStorage(AttachmentPrefix + "00000001")
+	a1.AddStream(stream(0x3707, PtypString), unicode("report.pdf"))
+	a1.AddStream(stream(0x3701, PtypBinary), []byte("%PDF"))
+
+	a2, _ := root.AddStorage(AttachmentPrefix + "00000002")
+	inner, _ := a2.AddStorage(stream(0x3701, PtypObject))
+	inner.AddStream(PropertiesStreamName, propertyStream(headerEmbedded))
+	inner.AddStream(stream(0x0037, PtypString), unicode("Inner"))
+	inner.AddStream(stream(0x1000, PtypString), unicode("Inner body"))
+	inner.AddStream(stream(0x007D, PtypString8), []byte("Subject: Inner\r\nContent-Type: text/html\r\nX-Mailer: Test\r\n\r\n"))
+
+	m := reopen(t, d)
+	var b bytes.Buffer
+	if err := m.WriteMIME(&b); err != nil {
+		t.Fatalf("WriteMIME: unexpected error [%v]", err)
+	}
+
+	msg, err := mail.ReadMessage(&b)
+	if err != nil {
+		t.Fatalf("ReadMessage: unexpected error [%v]", err)
+	}
+
+	dec := new(mime.WordDecoder)
+	subject, _ := dec.DecodeHeader(msg.Header.Get("Subject"))
+	date, _ := msg.Header.Date()
+	headers := []struct {
+		name, expect, got string
+	}{
+		{"From", `"Alice" <alice@example.com>`, msg.Header.Get("From")},
+		{"To", `"Bob" <bob@example.com>`, msg.Header.Get("To")},
+		{"Cc", `"Carol" <carol@example.com>`, msg.Header.Get("Cc")},
+		{"Subject", "Grüße", subject},
+		{"MIME-Version", "1.0", msg.Header.Get("MIME-Version")},
+		{"Date", when.String(), date.UTC().String()},
+	}
+	for testId, test := range headers {
+		if test.got != test.expect {
+			t.Errorf("Test [%d]: Expected %s [%s], got [%s]", testId, test.name, test.expect, test.got)
+		}
+	}
+
+	expect := []mimeLeaf{
+		{"multipart/mixed", ""},
+		{"multipart/alternative", ""},
+		{"text/plain", "Hello"},
+		{"multipart/related", ""},
+		{"text/html", `<p>Hello <img src="cid:logo@example.com"></p>`},
+		{"image/png", "\x89PNG"},
+		{"application/pdf", "%PDF"},
+		{"message/rfc822", ""},
+	}
+
+	leaves := readParts(t, msg.Header.Get("Content-Type"), msg.Header, msg.Body)
+	if len(leaves) != len(expect) {
+		t.Fatalf("Expected [%d] parts, got [%d]: %v", len(expect), len(leaves), leaves)
+	}
+	for testId, test := range expect {
+		if leaves[testId].contentType != test.contentType || (test.body != "" && leaves[testId].body != test.body) {
+			t.Errorf("Test [%d]: Expected [%v], got [%v]", testId, test, leaves[testId])
+		}
+	}
+
+	embedded, err := mail.ReadMessage(strings.NewReader(leaves[7].body))
+	if err != nil {
+		t.Fatalf("ReadMessage: unexpected error [%v]", err)
+	}
+	if embedded.Header.Get("X-Mailer") != "Test" || embedded.Header.Get("Subject") != "Inner" ||
+		!strings.HasPrefix(embedded.Header.Get("Content-Type"), "text/plain") {
+		t.Errorf("Expected transport headers, got [%v]", embedded.Header)
+	}
+	if body, _ := io.ReadAll(embedded.Body); string(body) != "Inner body" {
+		t.Errorf("Expected [Inner body], got [%s]", body)
+	}
+}
+
+func TestWriteMIMEDate(t *testing.T) {
+	when := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	filetime := cfb.NewFILETIME(when)
+
+	// PidTagClientSubmitTime stored with the wrong type is skipped for PidTagMessageDeliveryTime
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x0039, PtypString), unicode("Yesterday"))
+	root.AddStream(PropertiesStreamName, propertyStream(headerTopLevel,
+		fixed{0x0E06, PtypTime, uint64(filetime.HighDateTime)<<32 | uint64(filetime.LowDateTime)}))
+
+	var b bytes.Buffer
+	if err := reopen(t, d).WriteMIME(&b); err != nil {
+		t.Fatalf("WriteMIME: unexpected error [%v]", err)
+	}
+
+	msg, err := mail.ReadMessage(&b)
+	if err != nil {
+		t.Fatalf("ReadMessage: unexpected error [%v]", err)
+	}
+	if date, _ := msg.Header.Date(); !date.Equal(when) {
+		t.Errorf("Expected [%v], got [%v]", when, date)
+	}
+}
+
+func TestWriteMIMEString8(t *testing.T) {
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x0037, PtypString8), []byte("\xcf\xf0\xe8\xe2\xe5\xf2"))
+	root.AddStream(stream(0x0C1A, PtypString8), []byte("\xc8\xe2\xe0\xed"))
+	root.AddStream(stream(0x5D01, PtypString8), []byte("ivan@example.com"))
+	root.AddStream(stream(0x1000, PtypString8), []byte("\xc4\xee\xe1\xf0\xfb\xe9 \xe4\xe5\xed\xfc"))
+	root.AddStream(PropertiesStreamName, propertyStream(headerTopLevel, fixed{0x3FFD, PtypInteger32, 1251}))
+
+	var b bytes.Buffer
+	if err := reopen(t, d).WriteMIME(&b); err != nil {
+		t.Fatalf("WriteMIME: unexpected error [%v]", err)
+	}
+
+	msg, err := mail.ReadMessage(&b)
+	if err != nil {
+		t.Fatalf("ReadMessage: unexpected error [%v]", err)
+	}
+
+	dec := new(mime.WordDecoder)
+	subject, _ := dec.DecodeHeader(msg.Header.Get("Subject"))
+	from, err := mail.ParseAddress(msg.Header.Get("From"))
+	if err != nil {
+		t.Fatalf("ParseAddress: unexpected error [%v]", err)
+	}
+	body, _ := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	tests := []struct {
+		expect, got string
+	}{
+		{"Привет", subject},
+		{"Иван", from.Name},
+		{"Добрый день", string(body)},
+		{"text/plain; charset=utf-8", msg.Header.Get("Content-Type")},
+	}
+	for testId, test := range tests {
+		if test.got != test.expect {
+			t.Errorf("Test [%d]: Expected [%s], got [%q]", testId, test.expect, test.got)
+		}
+	}
+}
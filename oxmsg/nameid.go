@@ -0,0 +1,166 @@
+package oxmsg
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// NamedProperty is an entry of the named property mapping, either numeric (LID) or string named.
+type NamedProperty struct {
+	ID   PropertyID // Property ID, 0x8000 and above
+	GUID cfb.GUID   // Property set
+	LID  uint32     // Numeric name, when Name is empty
+	Name string     // String name, eg. "x-mailer" in PS_INTERNET_HEADERS
+}
+
+// NameMap map the IDs of named properties to their names, as given by the __nameid_version1.0 storage.
+type NameMap map[PropertyID]*NamedProperty
+
+// ReadNameMap read the named property mapping of the message file. A file without named properties give an empty map.
+func ReadNameMap(d *cfb.Document) (names NameMap, err error) {
+	names = make(NameMap)
+	storage, err := d.Lookup(NameIDStorageName)
+	if err != nil {
+		return names, nil
+	}
+
+	guids, err := readAll(storage, NameIDGuidStreamName)
+	if err != nil {
+		return
+	}
+	entries, err := readAll(storage, NameIDEntryStreamName)
+	if err != nil {
+		return
+	}
+	strs, err := readAll(storage, NameIDStringStreamName)
+	if err != nil {
+		return
+	}
+
+	for ; len(entries) >= 8; entries = entries[8:] {
+		info := byteOrder.Uint32(entries[4:])
+		n := &NamedProperty{ID: namedPropertyMin + PropertyID(info>>16)}
+
+		switch index := int(info>>1) & 0x7FFF; {
+		case index == 1:
+			n.GUID = PS_MAPI
+		case index == 2:
+			n.GUID = PS_PUBLIC_STRINGS
+		case index >= 3 && (index-2)*16 <= len(guids):
+			n.GUID = readGUID(guids[(index-3)*16:])
+		default:
+			return nil, ErrNameID
+		}
+
+		if info&1 == 0 {
+			n.LID = byteOrder.Uint32(entries)
+		} else if n.Name, err = readName(strs, byteOrder.Uint32(entries)); err != nil {
+			return nil, err
+		}
+		names[n.ID] = n
+	}
+	return
+}
+
+// Property return the property of the name, a PidLid or PidName property if known.
+func (n *NamedProperty) Property(propType PropertyType) (p *Property, known bool) {
+	switch {
+	case n.Name != "":
+		p = GetPropertyByString(n.GUID, n.Name)
+	case n.GUID == PS_MAPI:
+		return resolveProperty(PropertyID(n.LID), propType)
+	default:
+		p = GetPropertyByLID(n.GUID, n.LID)
+	}
+
+	if p == nil {
+		return &Property{n.String(), n.ID, propType, 0, ""}, false
+	}
+	if p.Type != propType {
+		p = &Property{p.Name, p.ID, propType, p.Set, p.StringName}
+	}
+	return p, true
+}
+
+// String return the string name, or the property set and LID of numeric names.
+func (n *NamedProperty) String() string {
+	if n.Name != "" {
+		return n.Name
+	}
+	return fmt.Sprintf("%v:0x%04X", n.GUID, n.LID)
+}
+
+// resolve return the property of a property ID and type, named properties are resolved by the map.
+func (names NameMap) resolve(id PropertyID, propType PropertyType) (p *Property, known bool) {
+	if n := names[id]; n != nil && id >= namedPropertyMin {
+		return n.Property(propType)
+	}
+	return resolveProperty(id, propType)
+}
+
+// alias return the property name of a string named property, matched case insensitive, eg. "X-Mailer". A name of more
+// than one property set, eg. "Keywords" in PS_PUBLIC_STRINGS and "keywords" in PS_INTERNET_HEADERS, is that of
+// PS_INTERNET_HEADERS, otherwise that of the lowest property ID.
+func (names NameMap) alias(name string) string {
+	var match *NamedProperty
+	for _, n := range names {
+		if n.Name == "" || !strings.EqualFold(n.Name, name) {
+			continue
+		}
+
+		isHeader, matchIsHeader := n.GUID == PS_INTERNET_HEADERS, match != nil && match.GUID == PS_INTERNET_HEADERS
+		if match == nil || isHeader && !matchIsHeader || isHeader == matchIsHeader && n.ID < match.ID {
+			match = n
+		}
+	}
+
+	if match == nil {
+		return ""
+	}
+	p, _ := match.Property(PtypString)
+	return p.Name
+}
+
+func readAll(storage *cfb.DirectoryEntry, name string) (b []byte, err error) {
+	entry, err := storage.Lookup(name)
+	if err != nil {
+		return nil, nil // Empty streams may be left out
+	}
+
+	s, err := entry.Stream()
+	if err != nil {
+		return
+	}
+	return io.ReadAll(s)
+}
+
+func readGUID(b []byte) (g cfb.GUID) {
+	g.DataA = byteOrder.Uint32(b)
+	g.DataB = byteOrder.Uint16(b[4:])
+	g.DataC = byteOrder.Uint16(b[6:])
+	copy(g.DataD[:], b[8:16])
+	return
+}
+
+// readName read the length prefixed UTF-16 name at offset of the string stream.
+func readName(b []byte, offset uint32) (name string, err error) {
+	if uint64(offset)+4 > uint64(len(b)) {
+		return "", ErrNameID
+	}
+
+	size := byteOrder.Uint32(b[offset:])
+	b = b[offset+4:]
+	if uint64(size) > uint64(len(b)) || size%2 != 0 {
+		return "", ErrNameID
+	}
+
+	u := make([]uint16, size/2)
+	for i := range u {
+		u[i] = byteOrder.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(u)), nil
+}
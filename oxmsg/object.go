@@ -0,0 +1,97 @@
+package oxmsg
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// object is a storage holding the properties of a single attachment or recipient.
+type object struct {
+	storage    *cfb.DirectoryEntry
+	properties map[string][]*Entry
+	names      NameMap
+}
+
+func newObject(storage *cfb.DirectoryEntry, headerSize int, names NameMap) object {
+	return object{storage, properties(storage, headerSize, names), names}
+}
+
+// Storage return the storage of the object.
+func (o *object) Storage() *cfb.DirectoryEntry {
+	return o.storage
+}
+
+// Properties return the properties of the object storage, excluding those of any sub-storage.
+func (o *object) Properties() map[string][]*Entry {
+	return o.properties
+}
+
+// Get return the entries of a property name, or of a string named property, eg. "X-Mailer".
+func (o *object) Get(propertyID string) []*Entry {
+	return get(o.properties, o.names, propertyID)
+}
+
+// string return the first of the named string properties present.
+func (o *object) string(propertyIDs ...string) string {
+	return stringProperty(o.properties, propertyIDs...)
+}
+
+// properties collect the entries directly below a storage, ie. the properties of a single object, and the fixed
+// size properties of its properties stream. A missing or damaged properties stream is ignored.
+func properties(storage *cfb.DirectoryEntry, headerSize int, names NameMap) (m map[string][]*Entry) {
+	m = make(map[string][]*Entry)
+	for _, child := range storage.Children() {
+		if isValueStream(child.Name()) {
+			continue // Read by Entry.Value of the multiple valued property
+		}
+
+		e := newEntry(child, names)
+		n := e.Name()
+		m[n] = append(m[n], e)
+	}
+
+	readPropertyStream(storage, headerSize, m, names)
+	return
+}
+
+// isValueStream return true for the value streams of multiple valued properties, eg. "__substg1.0_101F001F-00000001".
+func isValueStream(name string) bool {
+	return strings.HasPrefix(name, PropertyPrefix) && strings.IndexByte(name[len(PropertyPrefix):], '-') >= 0
+}
+
+func get(m map[string][]*Entry, names NameMap, propertyID string) []*Entry {
+	if e := m[propertyID]; e != nil {
+		return e
+	}
+	if alias := names.alias(propertyID); alias != "" {
+		return m[alias]
+	}
+	return nil
+}
+
+func stringProperty(m map[string][]*Entry, propertyIDs ...string) string {
+	for _, id := range propertyIDs {
+		if e := m[id]; e != nil {
+			if v, err := e[0].String(); err == nil {
+				return strings.TrimRight(v, "\x00")
+			}
+		}
+	}
+	return ""
+}
+
+// children return the sub-storages of a storage with the given name prefix, ordered by name.
+func children(storage *cfb.DirectoryEntry, prefix string) (entries []*cfb.DirectoryEntry) {
+	for _, child := range storage.Children() {
+		if child.Type == cfb.STGTY_STORAGE && strings.HasPrefix(child.Name(), prefix) {
+			entries = append(entries, child)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return
+}
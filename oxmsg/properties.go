@@ -1,6 +1,7 @@
 package oxmsg
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -9,8 +10,11 @@ import (
 
 type Property struct {
 	Name string
-	ID   PropertyID
+	ID   PropertyID // The LID of PidLid properties, 0 for PidName properties
 	Type PropertyType
+	Set  PropertyID // A Pset constant for PidLid and PidName properties, see Guid
+
+	StringName string // The name of PidName properties in the property set, eg. "content-type"
 }
 
 func ParseProperty(id string) (p *Property, known bool, err error) {
@@ -25,13 +29,20 @@ func ParseProperty(id string) (p *Property, known bool, err error) {
 		return
 	}
 
-	propType := PropertyType(v & 0xFFFF)
-	propID := PropertyID(v >> 16)
-	p = GetPropertyByID(propID)
-	if p == nil {
-		p = &Property{src[:4], propID, propType}
-	} else {
+	p, known = resolveProperty(PropertyID(v>>16), PropertyType(v&0xFFFF))
+	return
+}
+
+// resolveProperty return the known property of ID and type, or an unnamed property named by the hex ID.
+func resolveProperty(propID PropertyID, propType PropertyType) (p *Property, known bool) {
+	if p = GetPropertyByTag(propID, propType); p != nil {
+		known = true
+	} else if p = GetPropertyByID(propID); p != nil {
+		// Same property, stored with another type (eg. PtypString8 instead of PtypString)
+		p = &Property{p.Name, propID, propType, 0, ""}
 		known = true
+	} else {
+		p = &Property{fmt.Sprintf("%04X", uint32(propID)), propID, propType, 0, ""}
 	}
 	return
 }
@@ -41,7 +52,7 @@ func (p *Property) Range() *Range {
 }
 
 func (p *Property) Guid() cfb.GUID {
-	switch p.ID {
+	switch p.Set {
 	case PsetPublicStrings:
 		return PS_PUBLIC_STRINGS
 	case PsetCommon:
@@ -76,6 +87,8 @@ func (p *Property) Guid() cfb.GUID {
 		return PSETID_XmlExtractedEntities
 	case PsetAttachment:
 		return PSETID_Attachment
+	case PsetCalendarAssistant:
+		return PSETID_CalendarAssistant
 	}
 	return cfb.CLSID_NULL
 }
@@ -1163,1083 +1176,1083 @@ const (
 )
 
 var Properties = []Property{
-	{PidLidAddressBookProviderArrayType, 0x00008029, PtypInteger32},
-	{PidLidAddressBookProviderEmailList, 0x00008028, PtypMultipleInteger32},
-	{PidLidAddressCountryCode, 0x000080DD, PtypString},
-	{PidLidAgingDontAgeMe, 0x0000850E, PtypBoolean},
-	{PidLidAllAttendeesString, 0x00008238, PtypString},
-	{PidLidAllowExternalCheck, 0x00008246, PtypBoolean},
-	{PidLidAnniversaryEventEntryId, 0x0000804E, PtypBinary},
-	{PidLidAppointmentAuxiliaryFlags, 0x00008207, PtypInteger32},
-	{PidLidAppointmentColor, 0x00008214, PtypInteger32},
-	{PidLidAppointmentCounterProposal, 0x00008257, PtypBoolean},
-	{PidLidAppointmentDuration, 0x00008213, PtypInteger32},
-	{PidLidAppointmentEndDate, 0x00008211, PtypTime},
-	{PidLidAppointmentEndTime, 0x00008210, PtypTime},
-	{PidLidAppointmentEndWhole, 0x0000820E, PtypTime},
-	{PidLidAppointmentLastSequence, 0x00008203, PtypInteger32},
-	{PidLidAppointmentMessageClass, 0x00000024, PtypString},
-	{PidLidAppointmentNotAllowPropose, 0x0000825A, PtypBoolean},
-	{PidLidAppointmentProposalNumber, 0x00008259, PtypInteger32},
-	{PidLidAppointmentProposedDuration, 0x00008256, PtypInteger32},
-	{PidLidAppointmentProposedEndWhole, 0x00008251, PtypTime},
-	{PidLidAppointmentProposedStartWhole, 0x00008250, PtypTime},
-	{PidLidAppointmentRecur, 0x00008216, PtypBinary},
-	{PidLidAppointmentReplyName, 0x00008230, PtypString},
-	{PidLidAppointmentReplyTime, 0x00008220, PtypTime},
-	{PidLidAppointmentSequence, 0x00008201, PtypInteger32},
-	{PidLidAppointmentSequenceTime, 0x00008202, PtypTime},
-	{PidLidAppointmentStartDate, 0x00008212, PtypTime},
-	{PidLidAppointmentStartTime, 0x0000820F, PtypTime},
-	{PidLidAppointmentStartWhole, 0x0000820D, PtypTime},
-	{PidLidAppointmentStateFlags, 0x00008217, PtypInteger32},
-	{PidLidAppointmentSubType, 0x00008215, PtypBoolean},
-	{PidLidAppointmentTimeZoneDefinitionEndDisplay, 0x0000825F, PtypBinary},
-	{PidLidAppointmentTimeZoneDefinitionRecur, 0x00008260, PtypBinary},
-	{PidLidAppointmentTimeZoneDefinitionStartDisplay, 0x0000825E, PtypBinary},
-	{PidLidAppointmentUnsendableRecipients, 0x0000825D, PtypBinary},
-	{PidLidAppointmentUpdateTime, 0x00008226, PtypTime},
-	{PidLidAttendeeCriticalChange, 0x00000001, PtypTime},
-	{PidLidAutoFillLocation, 0x0000823A, PtypBoolean},
-	{PidLidAutoLog, 0x00008025, PtypBoolean},
-	{PidLidAutoProcessState, 0x0000851A, PtypInteger32},
-	{PidLidAutoStartCheck, 0x00008244, PtypBoolean},
-	{PidLidBilling, 0x00008535, PtypString},
-	{PidLidBirthdayEventEntryId, 0x0000804D, PtypBinary},
-	{PidLidBirthdayLocal, 0x000080DE, PtypTime},
-	{PidLidBusinessCardCardPicture, 0x00008041, PtypBinary},
-	{PidLidBusinessCardDisplayDefinition, 0x00008040, PtypBinary},
-	{PidLidBusyStatus, 0x00008205, PtypInteger32},
-	{PidLidCalendarType, 0x0000001C, PtypInteger32},
-	{PidLidCategories, 0x00009000, PtypMultipleString},
-	{PidLidCcAttendeesString, 0x0000823C, PtypString},
-	{PidLidChangeHighlight, 0x00008204, PtypInteger32},
-	{PidLidClassification, 0x000085B6, PtypString},
-	{PidLidClassificationDescription, 0x000085B7, PtypString},
-	{PidLidClassificationGuid, 0x000085B8, PtypString},
-	{PidLidClassificationKeep, 0x000085BA, PtypBoolean},
-	{PidLidClassified, 0x000085B5, PtypBoolean},
-	{PidLidCleanGlobalObjectId, 0x00000023, PtypBinary},
-	{PidLidClientIntent, 0x00000015, PtypInteger32},
-	{PidLidClipEnd, 0x00008236, PtypTime},
-	{PidLidClipStart, 0x00008235, PtypTime},
-	{PidLidCollaborateDoc, 0x00008247, PtypString},
-	{PidLidCommonEnd, 0x00008517, PtypTime},
-	{PidLidCommonStart, 0x00008516, PtypTime},
-	{PidLidCompanies, 0x00008539, PtypMultipleString},
-	{PidLidConferencingCheck, 0x00008240, PtypBoolean},
-	{PidLidConferencingType, 0x00008241, PtypInteger32},
-	{PidLidContactCharacterSet, 0x00008023, PtypInteger32},
-	{PidLidContactItemData, 0x00008007, PtypMultipleInteger32},
-	{PidLidContactLinkedGlobalAddressListEntryId, 0x000080E2, PtypBinary},
-	{PidLidContactLinkEntry, 0x00008585, PtypBinary},
-	{PidLidContactLinkGlobalAddressListLinkId, 0x000080E8, PtypGuid},
-	{PidLidContactLinkGlobalAddressListLinkState, 0x000080E6, PtypInteger32},
-	{PidLidContactLinkLinkRejectHistory, 0x000080E5, PtypMultipleBinary},
-	{PidLidContactLinkName, 0x00008586, PtypString},
-	{PidLidContactLinkSearchKey, 0x00008584, PtypBinary},
-	{PidLidContactLinkSMTPAddressCache, 0x000080E3, PtypMultipleString},
-	{PidLidContacts, 0x0000853A, PtypMultipleString},
-	{PidLidContactUserField1, 0x0000804F, PtypString},
-	{PidLidContactUserField2, 0x00008050, PtypString},
-	{PidLidContactUserField3, 0x00008051, PtypString},
-	{PidLidContactUserField4, 0x00008052, PtypString},
-	{PidLidConversationActionLastAppliedTime, 0x000085CA, PtypTime},
-	{PidLidConversationActionMaxDeliveryTime, 0x000085C8, PtypTime},
-	{PidLidConversationActionMoveFolderEid, 0x000085C6, PtypBinary},
-	{PidLidConversationActionMoveStoreEid, 0x000085C7, PtypBinary},
-	{PidLidConversationActionVersion, 0x000085CB, PtypInteger32},
-	{PidLidConversationProcessed, 0x000085C9, PtypInteger32},
-	{PidLidCurrentVersion, 0x00008552, PtypInteger32},
-	{PidLidCurrentVersionName, 0x00008554, PtypString},
-	{PidLidDayInterval, 0x00000011, PtypInteger16},
-	{PidLidDayOfMonth, 0x00001000, PtypInteger32},
-	{PidLidDelegateMail, 0x00000009, PtypBoolean},
-	{PidLidDepartment, 0x00008010, PtypString},
-	{PidLidDirectory, 0x00008242, PtypString},
-	{PidLidDistributionListChecksum, 0x0000804C, PtypInteger32},
-	{PidLidDistributionListMembers, 0x00008055, PtypMultipleBinary},
-	{PidLidDistributionListName, 0x00008053, PtypString},
-	{PidLidDistributionListOneOffMembers, 0x00008054, PtypMultipleBinary},
-	{PidLidDistributionListStream, 0x00008064, PtypBinary},
-	{PidLidEmail1AddressType, 0x00008082, PtypString},
-	{PidLidEmail1DisplayName, 0x00008080, PtypString},
-	{PidLidEmail1EmailAddress, 0x00008083, PtypString},
-	{PidLidEmail1OriginalDisplayName, 0x00008084, PtypString},
-	{PidLidEmail1OriginalEntryId, 0x00008085, PtypBinary},
-	{PidLidEmail2AddressType, 0x00008092, PtypString},
-	{PidLidEmail2DisplayName, 0x00008090, PtypString},
-	{PidLidEmail2EmailAddress, 0x00008093, PtypString},
-	{PidLidEmail2OriginalDisplayName, 0x00008094, PtypString},
-	{PidLidEmail2OriginalEntryId, 0x00008095, PtypBinary},
-	{PidLidEmail3AddressType, 0x000080A2, PtypString},
-	{PidLidEmail3DisplayName, 0x000080A0, PtypString},
-	{PidLidEmail3EmailAddress, 0x000080A3, PtypString},
-	{PidLidEmail3OriginalDisplayName, 0x000080A4, PtypString},
-	{PidLidEmail3OriginalEntryId, 0x000080A5, PtypBinary},
-	{PidLidEndRecurrenceDate, 0x0000000F, PtypInteger32},
-	{PidLidEndRecurrenceTime, 0x00000010, PtypInteger32},
-	{PidLidExceptionReplaceTime, 0x00008228, PtypTime},
-	{PidLidFax1AddressType, 0x000080B2, PtypString},
-	{PidLidFax1EmailAddress, 0x000080B3, PtypString},
-	{PidLidFax1OriginalDisplayName, 0x000080B4, PtypString},
-	{PidLidFax1OriginalEntryId, 0x000080B5, PtypBinary},
-	{PidLidFax2AddressType, 0x000080C2, PtypString},
-	{PidLidFax2EmailAddress, 0x000080C3, PtypString},
-	{PidLidFax2OriginalDisplayName, 0x000080C4, PtypString},
-	{PidLidFax2OriginalEntryId, 0x000080C5, PtypBinary},
-	{PidLidFax3AddressType, 0x000080D2, PtypString},
-	{PidLidFax3EmailAddress, 0x000080D3, PtypString},
-	{PidLidFax3OriginalDisplayName, 0x000080D4, PtypString},
-	{PidLidFax3OriginalEntryId, 0x000080D5, PtypBinary},
-	{PidLidFExceptionalAttendees, 0x0000822B, PtypBoolean},
-	{PidLidFExceptionalBody, 0x00008206, PtypBoolean},
-	{PidLidFileUnder, 0x00008005, PtypString},
-	{PidLidFileUnderId, 0x00008006, PtypInteger32},
-	{PidLidFileUnderList, 0x00008026, PtypMultipleInteger32},
-	{PidLidFInvited, 0x00008229, PtypBoolean},
-	{PidLidFlagRequest, 0x00008530, PtypString},
-	{PidLidFlagString, 0x000085C0, PtypInteger32},
-	{PidLidForwardInstance, 0x0000820A, PtypBoolean},
-	{PidLidForwardNotificationRecipients, 0x00008261, PtypBinary},
-	{PidLidFOthersAppointment, 0x0000822F, PtypBoolean},
-	{PidLidFreeBusyLocation, 0x000080D8, PtypString},
-	{PidLidGlobalObjectId, 0x00000003, PtypBinary},
-	{PidLidHasPicture, 0x00008015, PtypBoolean},
-	{PidLidHomeAddress, 0x0000801A, PtypString},
-	{PidLidHomeAddressCountryCode, 0x000080DA, PtypString},
-	{PidLidHtml, 0x0000802B, PtypString},
-	{PidLidICalendarDayOfWeekMask, 0x00001001, PtypInteger32},
-	{PidLidInboundICalStream, 0x0000827A, PtypBinary},
-	{PidLidInfoPathFormName, 0x000085B1, PtypString},
-	{PidLidInstantMessagingAddress, 0x00008062, PtypString},
-	{PidLidIntendedBusyStatus, 0x00008224, PtypInteger32},
-	{PidLidInternetAccountName, 0x00008580, PtypString},
-	{PidLidInternetAccountStamp, 0x00008581, PtypString},
-	{PidLidIsContactLinked, 0x000080E0, PtypBoolean},
-	{PidLidIsException, 0x0000000A, PtypBoolean},
-	{PidLidIsRecurring, 0x00000005, PtypBoolean},
-	{PidLidIsSilent, 0x00000004, PtypBoolean},
-	{PidLidLinkedTaskItems, 0x0000820C, PtypMultipleBinary},
-	{PidLidLocation, 0x00008208, PtypString},
-	{PidLidLogDocumentPosted, 0x00008711, PtypBoolean},
-	{PidLidLogDocumentPrinted, 0x0000870E, PtypBoolean},
-	{PidLidLogDocumentRouted, 0x00008710, PtypBoolean},
-	{PidLidLogDocumentSaved, 0x0000870F, PtypBoolean},
-	{PidLidLogDuration, 0x00008707, PtypInteger32},
-	{PidLidLogEnd, 0x00008708, PtypTime},
-	{PidLidLogFlags, 0x0000870C, PtypInteger32},
-	{PidLidLogStart, 0x00008706, PtypTime},
-	{PidLidLogType, 0x00008700, PtypString},
-	{PidLidLogTypeDesc, 0x00008712, PtypString},
-	{PidLidMeetingType, 0x00000026, PtypInteger32},
-	{PidLidMeetingWorkspaceUrl, 0x00008209, PtypString},
-	{PidLidMonthInterval, 0x00000013, PtypInteger16},
-	{PidLidMonthOfYear, 0x00001006, PtypInteger32},
-	{PidLidMonthOfYearMask, 0x00000017, PtypInteger32},
-	{PidLidNetShowUrl, 0x00008248, PtypString},
-	{PidLidNoEndDateFlag, 0x0000100B, PtypBoolean},
-	{PidLidNonSendableBcc, 0x00008538, PtypString},
-	{PidLidNonSendableCc, 0x00008537, PtypString},
-	{PidLidNonSendableTo, 0x00008536, PtypString},
-	{PidLidNonSendBccTrackStatus, 0x00008545, PtypMultipleInteger32},
-	{PidLidNonSendCcTrackStatus, 0x00008544, PtypMultipleInteger32},
-	{PidLidNonSendToTrackStatus, 0x00008543, PtypMultipleInteger32},
-	{PidLidNoteColor, 0x00008B00, PtypInteger32},
-	{PidLidNoteHeight, 0x00008B03, PtypInteger32},
-	{PidLidNoteWidth, 0x00008B02, PtypInteger32},
-	{PidLidNoteX, 0x00008B04, PtypInteger32},
-	{PidLidNoteY, 0x00008B05, PtypInteger32},
-	{PidLidOccurrences, 0x00001005, PtypInteger32},
-	{PidLidOldLocation, 0x00000028, PtypString},
-	{PidLidOldRecurrenceType, 0x00000018, PtypInteger16},
-	{PidLidOldWhenEndWhole, 0x0000002A, PtypTime},
-	{PidLidOldWhenStartWhole, 0x00000029, PtypTime},
-	{PidLidOnlinePassword, 0x00008249, PtypString},
-	{PidLidOptionalAttendees, 0x00000007, PtypString},
-	{PidLidOrganizerAlias, 0x00008243, PtypString},
-	{PidLidOriginalStoreEntryId, 0x00008237, PtypBinary},
-	{PidLidOtherAddress, 0x0000801C, PtypString},
-	{PidLidOtherAddressCountryCode, 0x000080DC, PtypString},
-	{PidLidOwnerCriticalChange, 0x0000001A, PtypTime},
-	{PidLidOwnerName, 0x0000822E, PtypString},
-	{PidLidPendingStateForSiteMailboxDocument, 0x000085E0, PtypInteger32},
-	{PidLidPercentComplete, 0x00008102, PtypFloating64},
-	{PidLidPostalAddressId, 0x00008022, PtypInteger32},
-	{PidLidPostRssChannel, 0x00008904, PtypString},
-	{PidLidPostRssChannelLink, 0x00008900, PtypString},
-	{PidLidPostRssItemGuid, 0x00008903, PtypString},
-	{PidLidPostRssItemHash, 0x00008902, PtypInteger32},
-	{PidLidPostRssItemLink, 0x00008901, PtypString},
-	{PidLidPostRssItemXml, 0x00008905, PtypString},
-	{PidLidPostRssSubscription, 0x00008906, PtypString},
-	{PidLidPrivate, 0x00008506, PtypBoolean},
-	{PidLidPromptSendUpdate, 0x00008045, PtypBoolean},
-	{PidLidRecurrenceDuration, 0x0000100D, PtypInteger32},
-	{PidLidRecurrencePattern, 0x00008232, PtypString},
-	{PidLidRecurrenceType, 0x00008231, PtypInteger32},
-	{PidLidRecurring, 0x00008223, PtypBoolean},
-	{PidLidReferenceEntryId, 0x000085BD, PtypBinary},
-	{PidLidReminderDelta, 0x00008501, PtypInteger32},
-	{PidLidReminderFileParameter, 0x0000851F, PtypString},
-	{PidLidReminderOverride, 0x0000851C, PtypBoolean},
-	{PidLidReminderPlaySound, 0x0000851E, PtypBoolean},
-	{PidLidReminderSet, 0x00008503, PtypBoolean},
-	{PidLidReminderSignalTime, 0x00008560, PtypTime},
-	{PidLidReminderTime, 0x00008502, PtypTime},
-	{PidLidReminderTimeDate, 0x00008505, PtypTime},
-	{PidLidReminderTimeTime, 0x00008504, PtypTime},
-	{PidLidReminderType, 0x0000851D, PtypInteger32},
-	{PidLidRemoteStatus, 0x00008511, PtypInteger32},
-	{PidLidRequiredAttendees, 0x00000006, PtypString},
-	{PidLidResourceAttendees, 0x00000008, PtypString},
-	{PidLidResponseStatus, 0x00008218, PtypInteger32},
-	{PidLidServerProcessed, 0x000085CC, PtypBoolean},
-	{PidLidServerProcessingActions, 0x000085CD, PtypInteger32},
-	{PidLidSharingAnonymity, 0x00008A19, PtypInteger32},
-	{PidLidSharingBindingEntryId, 0x00008A2D, PtypBinary},
-	{PidLidSharingBrowseUrl, 0x00008A51, PtypString},
-	{PidLidSharingCapabilities, 0x00008A17, PtypInteger32},
-	{PidLidSharingConfigurationUrl, 0x00008A24, PtypString},
-	{PidLidSharingDataRangeEnd, 0x00008A45, PtypTime},
-	{PidLidSharingDataRangeStart, 0x00008A44, PtypTime},
-	{PidLidSharingDetail, 0x00008A2B, PtypInteger32},
-	{PidLidSharingExtensionXml, 0x00008A21, PtypString},
-	{PidLidSharingFilter, 0x00008A13, PtypBinary},
-	{PidLidSharingFlags, 0x00008A0A, PtypInteger32},
-	{PidLidSharingFlavor, 0x00008A18, PtypInteger32},
-	{PidLidSharingFolderEntryId, 0x00008A15, PtypBinary},
-	{PidLidSharingIndexEntryId, 0x00008A2E, PtypBinary},
-	{PidLidSharingInitiatorEntryId, 0x00008A09, PtypBinary},
-	{PidLidSharingInitiatorName, 0x00008A07, PtypString},
-	{PidLidSharingInitiatorSmtp, 0x00008A08, PtypString},
-	{PidLidSharingInstanceGuid, 0x00008A1C, PtypBinary},
-	{PidLidSharingLastAutoSyncTime, 0x00008A55, PtypTime},
-	{PidLidSharingLastSyncTime, 0x00008A1F, PtypTime},
-	{PidLidSharingLocalComment, 0x00008A4D, PtypString},
-	{PidLidSharingLocalLastModificationTime, 0x00008A23, PtypTime},
-	{PidLidSharingLocalName, 0x00008A0F, PtypString},
-	{PidLidSharingLocalPath, 0x00008A0E, PtypString},
-	{PidLidSharingLocalStoreUid, 0x00008A49, PtypString},
-	{PidLidSharingLocalType, 0x00008A14, PtypString},
-	{PidLidSharingLocalUid, 0x00008A10, PtypString},
-	{PidLidSharingOriginalMessageEntryId, 0x00008A29, PtypBinary},
-	{PidLidSharingParentBindingEntryId, 0x00008A5C, PtypBinary},
-	{PidLidSharingParticipants, 0x00008A1E, PtypString},
-	{PidLidSharingPermissions, 0x00008A1B, PtypInteger32},
-	{PidLidSharingProviderExtension, 0x00008A0B, PtypString},
-	{PidLidSharingProviderGuid, 0x00008A01, PtypBinary},
-	{PidLidSharingProviderName, 0x00008A02, PtypString},
-	{PidLidSharingProviderUrl, 0x00008A03, PtypString},
-	{PidLidSharingRangeEnd, 0x00008A47, PtypInteger32},
-	{PidLidSharingRangeStart, 0x00008A46, PtypInteger32},
-	{PidLidSharingReciprocation, 0x00008A1A, PtypInteger32},
-	{PidLidSharingRemoteByteSize, 0x00008A4B, PtypInteger32},
-	{PidLidSharingRemoteComment, 0x00008A2F, PtypString},
-	{PidLidSharingRemoteCrc, 0x00008A4C, PtypInteger32},
-	{PidLidSharingRemoteLastModificationTime, 0x00008A22, PtypTime},
-	{PidLidSharingRemoteMessageCount, 0x00008A4F, PtypInteger32},
-	{PidLidSharingRemoteName, 0x00008A05, PtypString},
-	{PidLidSharingRemotePass, 0x00008A0D, PtypString},
-	{PidLidSharingRemotePath, 0x00008A04, PtypString},
-	{PidLidSharingRemoteStoreUid, 0x00008A48, PtypString},
-	{PidLidSharingRemoteType, 0x00008A1D, PtypString},
-	{PidLidSharingRemoteUid, 0x00008A06, PtypString},
-	{PidLidSharingRemoteUser, 0x00008A0C, PtypString},
-	{PidLidSharingRemoteVersion, 0x00008A5B, PtypString},
-	{PidLidSharingResponseTime, 0x00008A28, PtypTime},
-	{PidLidSharingResponseType, 0x00008A27, PtypInteger32},
-	{PidLidSharingRoamLog, 0x00008A4E, PtypInteger32},
-	{PidLidSharingStart, 0x00008A25, PtypTime},
-	{PidLidSharingStatus, 0x00008A00, PtypInteger32},
-	{PidLidSharingStop, 0x00008A26, PtypTime},
-	{PidLidSharingSyncFlags, 0x00008A60, PtypInteger32},
-	{PidLidSharingSyncInterval, 0x00008A2A, PtypInteger32},
-	{PidLidSharingTimeToLive, 0x00008A2C, PtypInteger32},
-	{PidLidSharingTimeToLiveAuto, 0x00008A56, PtypInteger32},
-	{PidLidSharingWorkingHoursDays, 0x00008A42, PtypInteger32},
-	{PidLidSharingWorkingHoursEnd, 0x00008A41, PtypTime},
-	{PidLidSharingWorkingHoursStart, 0x00008A40, PtypTime},
-	{PidLidSharingWorkingHoursTimeZone, 0x00008A43, PtypBinary},
-	{PidLidSideEffects, 0x00008510, PtypInteger32},
-	{PidLidSingleBodyICal, 0x0000827B, PtypBoolean},
-	{PidLidSmartNoAttach, 0x00008514, PtypBoolean},
-	{PidLidSpamOriginalFolder, 0x0000859C, PtypBinary},
-	{PidLidStartRecurrenceDate, 0x0000000D, PtypInteger32},
-	{PidLidStartRecurrenceTime, 0x0000000E, PtypInteger32},
-	{PidLidTaskAcceptanceState, 0x0000812A, PtypInteger32},
-	{PidLidTaskAccepted, 0x00008108, PtypBoolean},
-	{PidLidTaskActualEffort, 0x00008110, PtypInteger32},
-	{PidLidTaskAssigner, 0x00008121, PtypString},
-	{PidLidTaskAssigners, 0x00008117, PtypBinary},
-	{PidLidTaskComplete, 0x0000811C, PtypBoolean},
-	{PidLidTaskCustomFlags, 0x00008139, PtypInteger32},
-	{PidLidTaskDateCompleted, 0x0000810F, PtypTime},
-	{PidLidTaskDeadOccurrence, 0x00008109, PtypBoolean},
-	{PidLidTaskDueDate, 0x00008105, PtypTime},
-	{PidLidTaskEstimatedEffort, 0x00008111, PtypInteger32},
-	{PidLidTaskFCreator, 0x0000811E, PtypBoolean},
-	{PidLidTaskFFixOffline, 0x0000812C, PtypBoolean},
-	{PidLidTaskFRecurring, 0x00008126, PtypBoolean},
-	{PidLidTaskGlobalId, 0x00008519, PtypBinary},
-	{PidLidTaskHistory, 0x0000811A, PtypInteger32},
-	{PidLidTaskLastDelegate, 0x00008125, PtypString},
-	{PidLidTaskLastUpdate, 0x00008115, PtypTime},
-	{PidLidTaskLastUser, 0x00008122, PtypString},
-	{PidLidTaskMode, 0x00008518, PtypInteger32},
-	{PidLidTaskMultipleRecipients, 0x00008120, PtypInteger32},
-	{PidLidTaskNoCompute, 0x00008124, PtypBoolean},
-	{PidLidTaskOrdinal, 0x00008123, PtypInteger32},
-	{PidLidTaskOwner, 0x0000811F, PtypString},
-	{PidLidTaskOwnership, 0x00008129, PtypInteger32},
-	{PidLidTaskRecurrence, 0x00008116, PtypBinary},
-	{PidLidTaskResetReminder, 0x00008107, PtypBoolean},
-	{PidLidTaskRole, 0x00008127, PtypString},
-	{PidLidTaskStartDate, 0x00008104, PtypTime},
-	{PidLidTaskState, 0x00008113, PtypInteger32},
-	{PidLidTaskStatus, 0x00008101, PtypInteger32},
-	{PidLidTaskStatusOnComplete, 0x00008119, PtypBoolean},
-	{PidLidTaskUpdates, 0x0000811B, PtypBoolean},
-	{PidLidTaskVersion, 0x00008112, PtypInteger32},
-	{PidLidTeamTask, 0x00008103, PtypBoolean},
-	{PidLidTimeZone, 0x0000000C, PtypInteger32},
-	{PidLidTimeZoneDescription, 0x00008234, PtypString},
-	{PidLidTimeZoneStruct, 0x00008233, PtypBinary},
-	{PidLidToAttendeesString, 0x0000823B, PtypString},
-	{PidLidToDoOrdinalDate, 0x000085A0, PtypTime},
-	{PidLidToDoSubOrdinal, 0x000085A1, PtypString},
-	{PidLidToDoTitle, 0x000085A4, PtypString},
-	{PidLidUseTnef, 0x00008582, PtypBoolean},
-	{PidLidValidFlagStringProof, 0x000085BF, PtypTime},
-	{PidLidVerbResponse, 0x00008524, PtypString},
-	{PidLidVerbStream, 0x00008520, PtypBinary},
-	{PidLidWeddingAnniversaryLocal, 0x000080DF, PtypTime},
-	{PidLidWeekInterval, 0x00000012, PtypInteger16},
-	{PidLidWhere, 0x00000002, PtypString},
-	{PidLidWorkAddress, 0x0000801B, PtypString},
-	{PidLidWorkAddressCity, 0x00008046, PtypString},
-	{PidLidWorkAddressCountry, 0x00008049, PtypString},
-	{PidLidWorkAddressCountryCode, 0x000080DB, PtypString},
-	{PidLidWorkAddressPostalCode, 0x00008048, PtypString},
-	{PidLidWorkAddressPostOfficeBox, 0x0000804A, PtypString},
-	{PidLidWorkAddressState, 0x00008047, PtypString},
-	{PidLidWorkAddressStreet, 0x00008045, PtypString},
-	{PidLidYearInterval, 0x00000014, PtypInteger16},
-	{PidLidYomiCompanyName, 0x0000802E, PtypString},
-	{PidLidYomiFirstName, 0x0000802C, PtypString},
-	{PidLidYomiLastName, 0x0000802D, PtypString},
-	{PidNameAcceptLanguage, PsetInternetHeaders, PtypString},
-	{PidNameApplicationName, PsetPublicStrings, PtypString},
-	{PidNameAttachmentMacContentType, PsetAttachment, PtypString},
-	{PidNameAttachmentMacInfo, PsetAttachment, PtypBinary},
-	{PidNameAttachmentOriginalPermissionType, PsetAttachment, PtypInteger32},
-	{PidNameAttachmentPermissionType, PsetAttachment, PtypInteger32},
-	{PidNameAttachmentProviderType, PsetAttachment, PtypString},
-	{PidNameAudioNotes, PsetUnifiedMessaging, PtypString},
-	{PidNameAuthor, PsetPublicStrings, PtypString},
-	{PidNameAutomaticSpeechRecognitionData, PsetUnifiedMessaging, PtypBinary},
-	{PidNameBirthdayContactAttributionDisplayName, PsetAddress, PtypString},
-	{PidNameBirthdayContactEntryId, PsetAddress, PtypBinary},
-	{PidNameBirthdayContactPersonGuid, PsetAddress, PtypBinary},
-	{PidNameByteCount, PsetPublicStrings, PtypInteger32},
-	{PidNameCalendarAttendeeRole, PsetPublicStrings, PtypInteger32},
-	{PidNameCalendarBusystatus, PsetPublicStrings, PtypString},
-	{PidNameCalendarContact, PsetPublicStrings, PtypString},
-	{PidNameCalendarContactUrl, PsetPublicStrings, PtypString},
-	{PidNameCalendarCreated, PsetPublicStrings, PtypTime},
-	{PidNameCalendarDescriptionUrl, PsetPublicStrings, PtypString},
-	{PidNameCalendarDuration, PsetPublicStrings, PtypInteger32},
-	{PidNameCalendarExceptionDate, PsetPublicStrings, PtypMultipleTime},
-	{PidNameCalendarExceptionRule, PsetPublicStrings, PtypMultipleString},
-	{PidNameCalendarGeoLatitude, PsetPublicStrings, PtypFloating64},
-	{PidNameCalendarGeoLongitude, PsetPublicStrings, PtypFloating64},
-	{PidNameCalendarInstanceType, PsetPublicStrings, PtypInteger32},
-	{PidNameCalendarIsOrganizer, PsetPublicStrings, PtypBoolean},
-	{PidNameCalendarLastModified, PsetPublicStrings, PtypTime},
-	{PidNameCalendarLocationUrl, PsetPublicStrings, PtypString},
-	{PidNameCalendarMeetingStatus, PsetPublicStrings, PtypString},
-	{PidNameCalendarMethod, PsetPublicStrings, PtypString},
-	{PidNameCalendarProductId, PsetPublicStrings, PtypString},
-	{PidNameCalendarRecurrenceIdRange, PsetPublicStrings, PtypString},
-	{PidNameCalendarReminderOffset, PsetPublicStrings, PtypInteger32},
-	{PidNameCalendarResources, PsetPublicStrings, PtypString},
-	{PidNameCalendarRsvp, PsetPublicStrings, PtypBoolean},
-	{PidNameCalendarSequence, PsetPublicStrings, PtypInteger32},
-	{PidNameCalendarTimeZone, PsetPublicStrings, PtypString},
-	{PidNameCalendarTimeZoneId, PsetPublicStrings, PtypInteger32},
-	{PidNameCalendarTransparent, PsetPublicStrings, PtypString},
-	{PidNameCalendarUid, PsetPublicStrings, PtypString},
-	{PidNameCalendarVersion, PsetPublicStrings, PtypString},
-	{PidNameCategory, PsetPublicStrings, PtypString},
-	{PidNameCharacterCount, PsetPublicStrings, PtypInteger32},
-	{PidNameComments, PsetPublicStrings, PtypString},
-	{PidNameCompany, PsetPublicStrings, PtypString},
-	{PidNameContentBase, PsetInternetHeaders, PtypString},
-	{PidNameContentClass, PsetInternetHeaders, PtypString},
-	{PidNameContentType, PsetInternetHeaders, PtypString},
-	{PidNameCreateDateTimeReadOnly, PsetPublicStrings, PtypTime},
-	{PidNameCrossReference, PsetInternetHeaders, PtypString},
-	{PidNameDavId, PsetPublicStrings, PtypString},
-	{PidNameDavIsCollection, PsetPublicStrings, PtypBoolean},
-	{PidNameDavIsStructuredDocument, PsetPublicStrings, PtypBoolean},
-	{PidNameDavParentName, PsetPublicStrings, PtypString},
-	{PidNameDavUid, PsetPublicStrings, PtypString},
-	{PidNameDocumentParts, PsetPublicStrings, PtypMultipleString},
-	{PidNameEditTime, PsetPublicStrings, PtypString},
-	{PidNameExchangeIntendedBusyStatus, PsetPublicStrings, PtypString},
-	{PidNameExchangeJunkEmailMoveStamp, PsetPublicStrings, PtypInteger32},
-	{PidNameExchangeModifyExceptionStructure, PsetPublicStrings, PtypBinary},
-	{PidNameExchangeNoModifyExceptions, PsetPublicStrings, PtypBoolean},
-	{PidNameExchangePatternEnd, PsetPublicStrings, PtypTime},
-	{PidNameExchangePatternStart, PsetPublicStrings, PtypTime},
-	{PidNameExchangeReminderInterval, PsetPublicStrings, PtypInteger32},
-	{PidNameExchDatabaseSchema, PsetPublicStrings, PtypMultipleString},
-	{PidNameExchDataExpectedContentClass, PsetPublicStrings, PtypMultipleString},
-	{PidNameExchDataSchemaCollectionReference, PsetPublicStrings, PtypString},
-	{PidNameExtractedAddresses, PsetXmlExtractedEntities, PtypString},
-	{PidNameExtractedContacts, PsetXmlExtractedEntities, PtypString},
-	{PidNameExtractedEmails, PsetXmlExtractedEntities, PtypString},
-	{PidNameExtractedMeetings, PsetXmlExtractedEntities, PtypString},
-	{PidNameExtractedPhones, PsetXmlExtractedEntities, PtypString},
-	{PidNameExtractedTasks, PsetXmlExtractedEntities, PtypString},
-	{PidNameExtractedUrls, PsetXmlExtractedEntities, PtypString},
-	{PidNameFrom, PsetInternetHeaders, PtypString},
-	{PidNameHeadingPairs, PsetPublicStrings, PtypBinary},
-	{PidNameHiddenCount, PsetPublicStrings, PtypInteger32},
-	{PidNameHttpmailCalendar, PsetPublicStrings, PtypString},
-	{PidNameHttpmailHtmlDescription, PsetPublicStrings, PtypString},
-	{PidNameHttpmailSendMessage, PsetPublicStrings, PtypString},
-	{PidNameICalendarRecurrenceDate, PsetPublicStrings, PtypMultipleTime},
-	{PidNameICalendarRecurrenceRule, PsetPublicStrings, PtypMultipleString},
-	{PidNameInternetSubject, PsetInternetHeaders, PtypString},
-	{PidNameIsBirthdayContactWritable, PsetAddress, PtypBoolean},
-	{PidNameKeywords, PsetPublicStrings, PtypMultipleString},
-	{PidNameLastAuthor, PsetPublicStrings, PtypString},
-	{PidNameLastPrinted, PsetPublicStrings, PtypTime},
-	{PidNameLastSaveDateTime, PsetPublicStrings, PtypTime},
-	{PidNameLineCount, PsetPublicStrings, PtypInteger32},
-	{PidNameLinksDirty, PsetPublicStrings, PtypBoolean},
-	{PidNameLocationUrl, PsetPublicStrings, PtypString},
-	{PidNameManager, PsetPublicStrings, PtypString},
-	{PidNameMeetingDoNotForward, PsetPublicStrings, PtypBoolean},
-	{PidNameMSIPLabels, PsetInternetHeaders, PtypString},
-	{PidNameMultimediaClipCount, PsetPublicStrings, PtypInteger32},
-	{PidNameNoteCount, PsetPublicStrings, PtypInteger32},
-	{PidNameOMSAccountGuid, PsetPublicStrings, PtypString},
-	{PidNameOMSMobileModel, PsetPublicStrings, PtypString},
-	{PidNameOMSScheduleTime, PsetPublicStrings, PtypTime},
-	{PidNameOMSServiceType, PsetPublicStrings, PtypInteger32},
-	{PidNameOMSSourceType, PsetPublicStrings, PtypInteger32},
-	{PidNamePageCount, PsetPublicStrings, PtypInteger32},
-	{PidNameParagraphCount, PsetPublicStrings, PtypInteger32},
-	{PidNamePhishingStamp, PsetPublicStrings, PtypInteger32},
-	{PidNamePresentationFormat, PsetPublicStrings, PtypString},
-	{PidNameQuarantineOriginalSender, PsetPublicStrings, PtypString},
-	{PidNameRevisionNumber, PsetPublicStrings, PtypString},
-	{PidNameRightsManagementLicense, PsetPublicStrings, PtypMultipleBinary},
-	{PidNameScale, PsetPublicStrings, PtypBoolean},
-	{PidNameSecurity, PsetPublicStrings, PtypInteger32},
-	{PidNameSlideCount, PsetPublicStrings, PtypInteger32},
-	{PidNameSubject, PsetPublicStrings, PtypString},
-	{PidNameTemplate, PsetPublicStrings, PtypString},
-	{PidNameThumbnail, PsetPublicStrings, PtypBinary},
-	{PidNameTitle, PsetPublicStrings, PtypString},
-	{PidNameWordCount, PsetPublicStrings, PtypInteger32},
-	{PidNameXCallId, PsetInternetHeaders, PtypString},
-	{PidNameXFaxNumberOfPages, PsetInternetHeaders, PtypInteger16},
-	{PidNameXRequireProtectedPlayOnPhone, PsetInternetHeaders, PtypBoolean},
-	{PidNameXSenderTelephoneNumber, PsetInternetHeaders, PtypString},
-	{PidNameXSharingBrowseUrl, PsetInternetHeaders, PtypString},
-	{PidNameXSharingCapabilities, PsetInternetHeaders, PtypString},
-	{PidNameXSharingConfigUrl, PsetInternetHeaders, PtypString},
-	{PidNameXSharingExendedCaps, PsetInternetHeaders, PtypString},
-	{PidNameXSharingFlavor, PsetInternetHeaders, PtypString},
-	{PidNameXSharingInstanceGuid, PsetInternetHeaders, PtypString},
-	{PidNameXSharingLocalType, PsetInternetHeaders, PtypString},
-	{PidNameXSharingProviderGuid, PsetInternetHeaders, PtypString},
-	{PidNameXSharingProviderName, PsetInternetHeaders, PtypString},
-	{PidNameXSharingProviderUrl, PsetInternetHeaders, PtypString},
-	{PidNameXSharingRemoteName, PsetInternetHeaders, PtypString},
-	{PidNameXSharingRemotePath, PsetInternetHeaders, PtypString},
-	{PidNameXSharingRemoteStoreUid, PsetInternetHeaders, PtypString},
-	{PidNameXSharingRemoteType, PsetInternetHeaders, PtypString},
-	{PidNameXSharingRemoteUid, PsetInternetHeaders, PtypString},
-	{PidNameXVoiceMessageAttachmentOrder, PsetInternetHeaders, PtypString},
-	{PidNameXVoiceMessageDuration, PsetInternetHeaders, PtypInteger16},
-	{PidNameXVoiceMessageSenderName, PsetInternetHeaders, PtypString},
-	{PidTagAccess, 0x0FF4, PtypInteger32},
-	{PidTagAccessControlListData, 0x3FE0, PtypBinary},
-	{PidTagAccessLevel, 0x0FF7, PtypInteger32},
-	{PidTagAccount, 0x3A00, PtypString},
-	{PidTagAdditionalRenEntryIds, 0x36D8, PtypMultipleBinary},
-	{PidTagAdditionalRenEntryIdsEx, 0x36D9, PtypBinary},
-	{PidTagAddressBookAuthorizedSenders, 0x8CD8, PtypObject},
-	{PidTagAddressBookContainerId, 0xFFFD, PtypInteger32},
-	{PidTagAddressBookDeliveryContentLength, 0x806A, PtypInteger32},
-	{PidTagAddressBookDisplayNamePrintable, 0x39FF, PtypString},
-	{PidTagAddressBookDisplayTypeExtended, 0x8C93, PtypInteger32},
-	{PidTagAddressBookDistributionListExternalMemberCount, 0x8CE3, PtypInteger32},
-	{PidTagAddressBookDistributionListMemberCount, 0x8CE2, PtypInteger32},
-	{PidTagAddressBookDistributionListMemberSubmitAccepted, 0x8073, PtypObject},
-	{PidTagAddressBookDistributionListMemberSubmitRejected, 0x8CDA, PtypObject},
-	{PidTagAddressBookDistributionListRejectMessagesFromDLMembers, 0x8CDB, PtypObject},
-	{PidTagAddressBookEntryId, 0x663B, PtypBinary},
-	{PidTagAddressBookExtensionAttribute1, 0x802D, PtypString},
-	{PidTagAddressBookExtensionAttribute10, 0x8036, PtypString},
-	{PidTagAddressBookExtensionAttribute11, 0x8C57, PtypString},
-	{PidTagAddressBookExtensionAttribute12, 0x8C58, PtypString},
-	{PidTagAddressBookExtensionAttribute13, 0x8C59, PtypString},
-	{PidTagAddressBookExtensionAttribute14, 0x8C60, PtypString},
-	{PidTagAddressBookExtensionAttribute15, 0x8C61, PtypString},
-	{PidTagAddressBookExtensionAttribute2, 0x802E, PtypString},
-	{PidTagAddressBookExtensionAttribute3, 0x802F, PtypString},
-	{PidTagAddressBookExtensionAttribute4, 0x8030, PtypString},
-	{PidTagAddressBookExtensionAttribute5, 0x8031, PtypString},
-	{PidTagAddressBookExtensionAttribute6, 0x8032, PtypString},
-	{PidTagAddressBookExtensionAttribute7, 0x8033, PtypString},
-	{PidTagAddressBookExtensionAttribute8, 0x8034, PtypString},
-	{PidTagAddressBookExtensionAttribute9, 0x8035, PtypString},
-	{PidTagAddressBookFolderPathname, 0x8004, PtypString},
-	{PidTagAddressBookHierarchicalChildDepartments, 0x8C9A, PtypObject},
-	{PidTagAddressBookHierarchicalDepartmentMembers, 0x8C97, PtypObject},
-	{PidTagAddressBookHierarchicalIsHierarchicalGroup, 0x8CDD, PtypBoolean},
-	{PidTagAddressBookHierarchicalParentDepartment, 0x8C99, PtypObject},
-	{PidTagAddressBookHierarchicalRootDepartment, 0x8C98, PtypString8},
-	{PidTagAddressBookHierarchicalShowInDepartments, 0x8C94, PtypObject},
-	{PidTagAddressBookHomeMessageDatabase, 0x8006, PtypString8},
-	{PidTagAddressBookIsMaster, 0xFFFB, PtypBoolean},
-	{PidTagAddressBookIsMemberOfDistributionList, 0x8008, PtypString8},
-	{PidTagAddressBookManageDistributionList, 0x6704, PtypObject},
-	{PidTagAddressBookManager, 0x8005, PtypObject},
-	{PidTagAddressBookManagerDistinguishedName, 0x8005, PtypString},
-	{PidTagAddressBookMember, 0x8009, PtypObject},
-	{PidTagAddressBookMessageId, 0x674F, PtypInteger64},
-	{PidTagAddressBookModerationEnabled, 0x8CB5, PtypBoolean},
-	{PidTagAddressBookNetworkAddress, 0x8170, PtypMultipleString},
-	{PidTagAddressBookObjectDistinguishedName, 0x803C, PtypString},
-	{PidTagAddressBookObjectGuid, 0x8C6D, PtypBinary},
-	{PidTagAddressBookOrganizationalUnitRootDistinguishedName, 0x8CA8, PtypString},
-	{PidTagAddressBookOwner, 0x800C, PtypObject},
-	{PidTagAddressBookOwnerBackLink, 0x8024, PtypObject},
-	{PidTagAddressBookParentEntryId, 0xFFFC, PtypBinary},
-	{PidTagAddressBookPhoneticCompanyName, 0x8C91, PtypString},
-	{PidTagAddressBookPhoneticDepartmentName, 0x8C90, PtypString},
-	{PidTagAddressBookPhoneticDisplayName, 0x8C92, PtypString},
-	{PidTagAddressBookPhoneticGivenName, 0x8C8E, PtypString},
-	{PidTagAddressBookPhoneticSurname, 0x8C8F, PtypString},
-	{PidTagAddressBookProxyAddresses, 0x800F, PtypMultipleString},
-	{PidTagAddressBookPublicDelegates, 0x8015, PtypObject},
-	{PidTagAddressBookReports, 0x800E, PtypObject},
-	{PidTagAddressBookRoomCapacity, 0x0807, PtypInteger32},
-	{PidTagAddressBookRoomContainers, 0x8C96, PtypMultipleString},
-	{PidTagAddressBookRoomDescription, 0x0809, PtypString},
-	{PidTagAddressBookSenderHintTranslations, 0x8CAC, PtypMultipleString},
-	{PidTagAddressBookSeniorityIndex, 0x8CA0, PtypInteger32},
-	{PidTagAddressBookTargetAddress, 0x8011, PtypString},
-	{PidTagAddressBookUnauthorizedSenders, 0x8CD9, PtypObject},
-	{PidTagAddressBookX509Certificate, 0x8C6A, PtypMultipleBinary},
-	{PidTagAddressType, 0x3002, PtypString},
-	{PidTagAlternateRecipientAllowed, PtypInteger16, PtypBoolean},
-	{PidTagAnr, 0x360C, PtypString},
-	{PidTagArchiveDate, 0x301F, PtypTime},
-	{PidTagArchivePeriod, 0x301E, PtypInteger32},
-	{PidTagArchiveTag, 0x3018, PtypBinary},
-	{PidTagAssistant, 0x3A30, PtypString},
-	{PidTagAssistantTelephoneNumber, 0x3A2E, PtypString},
-	{PidTagAssociated, 0x67AA, PtypBoolean},
-	{PidTagAttachAdditionalInformation, 0x370F, PtypBinary},
-	{PidTagAttachContentBase, 0x3711, PtypString},
-	{PidTagAttachContentId, 0x3712, PtypString},
-	{PidTagAttachContentLocation, 0x3713, PtypString},
-	{PidTagAttachDataBinary, 0x3701, PtypBinary},
-	{PidTagAttachDataObject, 0x3701, PtypObject},
-	{PidTagAttachEncoding, 0x3702, PtypBinary},
-	{PidTagAttachExtension, 0x3703, PtypString},
-	{PidTagAttachFilename, 0x3704, PtypString},
-	{PidTagAttachFlags, 0x3714, PtypInteger32},
-	{PidTagAttachLongFilename, 0x3707, PtypString},
-	{PidTagAttachLongPathname, 0x370D, PtypString},
-	{PidTagAttachmentContactPhoto, 0x7FFF, PtypBoolean},
-	{PidTagAttachmentFlags, 0x7FFD, PtypInteger32},
-	{PidTagAttachmentHidden, 0x7FFE, PtypBoolean},
-	{PidTagAttachmentLinkId, 0x7FFA, PtypInteger32},
-	{PidTagAttachMethod, 0x3705, PtypInteger32},
-	{PidTagAttachMimeTag, 0x370E, PtypString},
-	{PidTagAttachNumber, 0x0E21, PtypInteger32},
-	{PidTagAttachPathname, 0x3708, PtypString},
-	{PidTagAttachPayloadClass, 0x371A, PtypString},
-	{PidTagAttachPayloadProviderGuidString, 0x3719, PtypString},
-	{PidTagAttachRendering, 0x3709, PtypBinary},
-	{PidTagAttachSize, 0x0E20, PtypInteger32},
-	{PidTagAttachTag, 0x370A, PtypBinary},
-	{PidTagAttachTransportName, 0x370C, PtypString},
-	{PidTagAttributeHidden, 0x10F4, PtypBoolean},
-	{PidTagAttributeReadOnly, 0x10F6, PtypBoolean},
-	{PidTagAutoForwardComment, 0x0004, PtypString},
-	{PidTagAutoForwarded, PtypFloating64, PtypBoolean},
-	{PidTagAutoResponseSuppress, 0x3FDF, PtypInteger32},
-	{PidTagBirthday, 0x3A42, PtypTime},
-	{PidTagBlockStatus, 0x1096, PtypInteger32},
-	{PidTagBody, 0x1000, PtypString},
-	{PidTagBodyContentId, 0x1015, PtypString},
-	{PidTagBodyContentLocation, 0x1014, PtypString},
-	{PidTagBodyHtml, 0x1013, PtypString},
-	{PidTagBusiness2TelephoneNumber, 0x3A1B, PtypString},
-	{PidTagBusiness2TelephoneNumbers, 0x3A1B, PtypMultipleString},
-	{PidTagBusinessFaxNumber, 0x3A24, PtypString},
-	{PidTagBusinessHomePage, 0x3A51, PtypString},
-	{PidTagBusinessTelephoneNumber, 0x3A08, PtypString},
-	{PidTagCallbackTelephoneNumber, 0x3A02, PtypString},
-	{PidTagCallId, 0x6806, PtypString},
-	{PidTagCarTelephoneNumber, 0x3A1E, PtypString},
-	{PidTagCdoRecurrenceid, 0x10C5, PtypTime},
-	{PidTagChangeKey, 0x65E2, PtypBinary},
-	{PidTagChangeNumber, 0x67A4, PtypInteger64},
-	{PidTagChildrensNames, 0x3A58, PtypMultipleString},
-	{PidTagClientActions, 0x6645, PtypBinary},
-	{PidTagClientSubmitTime, 0x0039, PtypTime},
-	{PidTagCodePageId, 0x66C3, PtypInteger32},
-	{PidTagComment, 0x3004, PtypString},
-	{PidTagCompanyMainTelephoneNumber, 0x3A57, PtypString},
-	{PidTagCompanyName, 0x3A16, PtypString},
-	{PidTagComputerNetworkName, 0x3A49, PtypString},
-	{PidTagConflictEntryId, 0x3FF0, PtypBinary},
-	{PidTagContainerClass, 0x3613, PtypString},
-	{PidTagContainerContents, 0x360F, PtypObject},
-	{PidTagContainerFlags, 0x3600, PtypInteger32},
-	{PidTagContainerHierarchy, 0x360E, PtypObject},
-	{PidTagContentCount, 0x3602, PtypInteger32},
-	{PidTagContentFilterSpamConfidenceLevel, 0x4076, PtypInteger32},
-	{PidTagContentUnreadCount, 0x3603, PtypInteger32},
-	{PidTagConversationId, 0x3013, PtypBinary},
-	{PidTagConversationIndex, 0x0071, PtypBinary},
-	{PidTagConversationIndexTracking, 0x3016, PtypBoolean},
-	{PidTagConversationTopic, 0x0070, PtypString},
-	{PidTagCountry, 0x3A26, PtypString},
-	{PidTagCreationTime, 0x3007, PtypTime},
-	{PidTagCreatorEntryId, 0x3FF9, PtypBinary},
-	{PidTagCreatorName, 0x3FF8, PtypString},
-	{PidTagCustomerId, 0x3A4A, PtypString},
-	{PidTagDamBackPatched, 0x6647, PtypBoolean},
-	{PidTagDamOriginalEntryId, 0x6646, PtypBinary},
-	{PidTagDefaultPostMessageClass, 0x36E5, PtypString},
-	{PidTagDeferredActionMessageOriginalEntryId, 0x6741, PtypServerId},
-	{PidTagDeferredDeliveryTime, 0x000F, PtypTime},
-	{PidTagDeferredSendNumber, 0x3FEB, PtypInteger32},
-	{PidTagDeferredSendTime, 0x3FEF, PtypTime},
-	{PidTagDeferredSendUnits, 0x3FEC, PtypInteger32},
-	{PidTagDelegatedByRule, 0x3FE3, PtypBoolean},
-	{PidTagDelegateFlags, 0x686B, PtypMultipleInteger32},
-	{PidTagDeleteAfterSubmit, 0x0E01, PtypBoolean},
-	{PidTagDeletedCountTotal, 0x670B, PtypInteger32},
-	{PidTagDeletedOn, 0x668F, PtypTime},
-	{PidTagDeliverTime, 0x0010, PtypTime},
-	{PidTagDepartmentName, 0x3A18, PtypString},
-	{PidTagDepth, 0x3005, PtypInteger32},
-	{PidTagDisplayBcc, 0x0E02, PtypString},
-	{PidTagDisplayCc, 0x0E03, PtypString},
-	{PidTagDisplayName, 0x3001, PtypString},
-	{PidTagDisplayNamePrefix, 0x3A45, PtypString},
-	{PidTagDisplayTo, 0x0E04, PtypString},
-	{PidTagDisplayType, 0x3900, PtypInteger32},
-	{PidTagDisplayTypeEx, 0x3905, PtypInteger32},
-	{PidTagEmailAddress, 0x3003, PtypString},
-	{PidTagEndDate, 0x0061, PtypTime},
-	{PidTagEntryId, 0x0FFF, PtypBinary},
-	{PidTagExceptionEndTime, 0x7FFC, PtypTime},
-	{PidTagExceptionReplaceTime, 0x7FF9, PtypTime},
-	{PidTagExceptionStartTime, 0x7FFB, PtypTime},
-	{PidTagExchangeNTSecurityDescriptor, 0x0E84, PtypBinary},
-	{PidTagExpiryNumber, 0x3FED, PtypInteger32},
-	{PidTagExpiryTime, 0x0015, PtypTime},
-	{PidTagExpiryUnits, 0x3FEE, PtypInteger32},
-	{PidTagExtendedFolderFlags, 0x36DA, PtypBinary},
-	{PidTagExtendedRuleMessageActions, 0x0E99, PtypBinary},
-	{PidTagExtendedRuleMessageCondition, 0x0E9A, PtypBinary},
-	{PidTagExtendedRuleSizeLimit, 0x0E9B, PtypInteger32},
-	{PidTagFaxNumberOfPages, 0x6804, PtypInteger32},
-	{PidTagFlagCompleteTime, 0x1091, PtypTime},
-	{PidTagFlagStatus, 0x1090, PtypInteger32},
-	{PidTagFlatUrlName, 0x670E, PtypString},
-	{PidTagFolderAssociatedContents, 0x3610, PtypObject},
-	{PidTagFolderId, 0x6748, PtypInteger64},
-	{PidTagFolderFlags, 0x66A8, PtypInteger32},
-	{PidTagFolderType, 0x3601, PtypInteger32},
-	{PidTagFollowupIcon, 0x1095, PtypInteger32},
-	{PidTagFreeBusyCountMonths, 0x6869, PtypInteger32},
-	{PidTagFreeBusyEntryIds, 0x36E4, PtypMultipleBinary},
-	{PidTagFreeBusyMessageEmailAddress, 0x6849, PtypString},
-	{PidTagFreeBusyPublishEnd, 0x6848, PtypInteger32},
-	{PidTagFreeBusyPublishStart, 0x6847, PtypInteger32},
-	{PidTagFreeBusyRangeTimestamp, 0x6868, PtypTime},
-	{PidTagFtpSite, 0x3A4C, PtypString},
-	{PidTagGatewayNeedsToRefresh, 0x6846, PtypBoolean},
-	{PidTagGender, 0x3A4D, PtypInteger16},
-	{PidTagGeneration, 0x3A05, PtypString},
-	{PidTagGivenName, 0x3A06, PtypString},
-	{PidTagGovernmentIdNumber, 0x3A07, PtypString},
-	{PidTagHasAttachments, 0x0E1B, PtypBoolean},
-	{PidTagHasDeferredActionMessages, 0x3FEA, PtypBoolean},
-	{PidTagHasNamedProperties, 0x664A, PtypBoolean},
-	{PidTagHasRules, 0x663A, PtypBoolean},
-	{PidTagHierarchyChangeNumber, 0x663E, PtypInteger32},
-	{PidTagHierRev, 0x4082, PtypTime},
-	{PidTagHobbies, 0x3A43, PtypString},
-	{PidTagHome2TelephoneNumber, 0x3A2F, PtypString},
-	{PidTagHome2TelephoneNumbers, 0x3A2F, PtypMultipleString},
-	{PidTagHomeAddressCity, 0x3A59, PtypString},
-	{PidTagHomeAddressCountry, 0x3A5A, PtypString},
-	{PidTagHomeAddressPostalCode, 0x3A5B, PtypString},
-	{PidTagHomeAddressPostOfficeBox, 0x3A5E, PtypString},
-	{PidTagHomeAddressStateOrProvince, 0x3A5C, PtypString},
-	{PidTagHomeAddressStreet, 0x3A5D, PtypString},
-	{PidTagHomeFaxNumber, 0x3A25, PtypString},
-	{PidTagHomeTelephoneNumber, 0x3A09, PtypString},
-	{PidTagHtml, 0x1013, PtypBinary},
-	{PidTagICalendarEndTime, 0x10C4, PtypTime},
-	{PidTagICalendarReminderNextTime, 0x10CA, PtypTime},
-	{PidTagICalendarStartTime, 0x10C3, PtypTime},
-	{PidTagIconIndex, 0x1080, PtypInteger32},
-	{PidTagImportance, 0x0017, PtypInteger32},
-	{PidTagInConflict, 0x666C, PtypBoolean},
-	{PidTagInitialDetailsPane, 0x3F08, PtypInteger32},
-	{PidTagInitials, 0x3A0A, PtypString},
-	{PidTagInReplyToId, 0x1042, PtypString},
-	{PidTagInstanceKey, 0x0FF6, PtypBinary},
-	{PidTagInstanceNum, 0x674E, PtypInteger32},
-	{PidTagInstID, 0x674D, PtypInteger64},
-	{PidTagInternetCodepage, 0x3FDE, PtypInteger32},
-	{PidTagInternetMailOverrideFormat, 0x5902, PtypInteger32},
-	{PidTagInternetMessageId, 0x1035, PtypString},
-	{PidTagInternetReferences, 0x1039, PtypString},
-	{PidTagIpmAppointmentEntryId, 0x36D0, PtypBinary},
-	{PidTagIpmContactEntryId, 0x36D1, PtypBinary},
-	{PidTagIpmDraftsEntryId, 0x36D7, PtypBinary},
-	{PidTagIpmJournalEntryId, 0x36D2, PtypBinary},
-	{PidTagIpmNoteEntryId, 0x36D3, PtypBinary},
-	{PidTagIpmTaskEntryId, 0x36D4, PtypBinary},
-	{PidTagIsdnNumber, 0x3A2D, PtypString},
-	{PidTagJunkAddRecipientsToSafeSendersList, 0x6103, PtypInteger32},
-	{PidTagJunkIncludeContacts, 0x6100, PtypInteger32},
-	{PidTagJunkPermanentlyDelete, 0x6102, PtypInteger32},
-	{PidTagJunkPhishingEnableLinks, 0x6107, PtypBoolean},
-	{PidTagJunkThreshold, 0x6101, PtypInteger32},
-	{PidTagKeyword, 0x3A0B, PtypString},
-	{PidTagLanguage, 0x3A0C, PtypString},
-	{PidTagLastModificationTime, 0x3008, PtypTime},
-	{PidTagLastModifierEntryId, 0x3FFB, PtypBinary},
-	{PidTagLastModifierName, 0x3FFA, PtypString},
-	{PidTagLastVerbExecuted, 0x1081, PtypInteger32},
-	{PidTagLastVerbExecutionTime, 0x1082, PtypTime},
-	{PidTagListHelp, 0x1043, PtypString},
-	{PidTagListSubscribe, 0x1044, PtypString},
-	{PidTagListUnsubscribe, 0x1045, PtypString},
-	{PidTagLocalCommitTime, 0x6709, PtypTime},
-	{PidTagLocalCommitTimeMax, 0x670A, PtypTime},
-	{PidTagLocaleId, 0x66A1, PtypInteger32},
-	{PidTagLocality, 0x3A27, PtypString},
-	{PidTagLocation, 0x3A0D, PtypString},
-	{PidTagMailboxOwnerEntryId, 0x661B, PtypBinary},
-	{PidTagMailboxOwnerName, 0x661C, PtypString},
-	{PidTagManagerName, 0x3A4E, PtypString},
-	{PidTagMappingSignature, 0x0FF8, PtypBinary},
-	{PidTagMaximumSubmitMessageSize, 0x666D, PtypInteger32},
-	{PidTagMemberId, 0x6671, PtypInteger64},
-	{PidTagMemberName, 0x6672, PtypString},
-	{PidTagMemberRights, 0x6673, PtypInteger32},
-	{PidTagMessageAttachments, 0x0E13, PtypObject},
-	{PidTagMessageCcMe, 0x0058, PtypBoolean},
-	{PidTagMessageClass, 0x001A, PtypString},
-	{PidTagMessageCodepage, 0x3FFD, PtypInteger32},
-	{PidTagMessageDeliveryTime, 0x0E06, PtypTime},
-	{PidTagMessageEditorFormat, 0x5909, PtypInteger32},
-	{PidTagMessageFlags, 0x0E07, PtypInteger32},
-	{PidTagMessageHandlingSystemCommonName, 0x3A0F, PtypString},
-	{PidTagMessageLocaleId, 0x3FF1, PtypInteger32},
-	{PidTagMessageRecipientMe, 0x0059, PtypBoolean},
-	{PidTagMessageRecipients, 0x0E12, PtypObject},
-	{PidTagMessageSize, 0x0E08, PtypInteger32},
-	{PidTagMessageSizeExtended, 0x0E08, PtypInteger64},
-	{PidTagMessageStatus, 0x0E17, PtypInteger32},
-	{PidTagMessageSubmissionId, 0x0047, PtypBinary},
-	{PidTagMessageToMe, 0x0057, PtypBoolean},
-	{PidTagMid, 0x674A, PtypInteger64},
-	{PidTagMiddleName, 0x3A44, PtypString},
-	{PidTagMimeSkeleton, 0x64F0, PtypBinary},
-	{PidTagMobileTelephoneNumber, 0x3A1C, PtypString},
-	{PidTagNativeBody, 0x1016, PtypInteger32},
-	{PidTagNextSendAcct, 0x0E29, PtypString},
-	{PidTagNickname, 0x3A4F, PtypString},
-	{PidTagNonDeliveryReportDiagCode, 0x0C05, PtypInteger32},
-	{PidTagNonDeliveryReportReasonCode, 0x0C04, PtypInteger32},
-	{PidTagNonDeliveryReportStatusCode, 0x0C20, PtypInteger32},
-	{PidTagNonReceiptNotificationRequested, 0x0C06, PtypBoolean},
-	{PidTagNormalizedSubject, 0x0E1D, PtypString},
-	{PidTagObjectType, 0x0FFE, PtypInteger32},
-	{PidTagOfficeLocation, 0x3A19, PtypString},
-	{PidTagOfflineAddressBookContainerGuid, 0x6802, PtypString8},
-	{PidTagOfflineAddressBookDistinguishedName, 0x6804, PtypString8},
-	{PidTagOfflineAddressBookMessageClass, 0x6803, PtypInteger32},
-	{PidTagOfflineAddressBookName, 0x6800, PtypString},
-	{PidTagOfflineAddressBookSequence, 0x6801, PtypInteger32},
-	{PidTagOfflineAddressBookTruncatedProperties, 0x6805, PtypMultipleInteger32},
-	{PidTagOrdinalMost, 0x36E2, PtypInteger32},
-	{PidTagOrganizationalIdNumber, 0x3A10, PtypString},
-	{PidTagOriginalAuthorEntryId, 0x004C, PtypBinary},
-	{PidTagOriginalAuthorName, 0x004D, PtypString},
-	{PidTagOriginalDeliveryTime, 0x0055, PtypTime},
-	{PidTagOriginalDisplayBcc, 0x0072, PtypString},
-	{PidTagOriginalDisplayCc, 0x0073, PtypString},
-	{PidTagOriginalDisplayTo, 0x0074, PtypString},
-	{PidTagOriginalEntryId, 0x3A12, PtypBinary},
-	{PidTagOriginalMessageClass, 0x004B, PtypString},
-	{PidTagOriginalMessageId, 0x1046, PtypString},
-	{PidTagOriginalSenderAddressType, 0x0066, PtypString},
-	{PidTagOriginalSenderEmailAddress, 0x0067, PtypString},
-	{PidTagOriginalSenderEntryId, 0x005B, PtypBinary},
-	{PidTagOriginalSenderName, 0x005A, PtypString},
-	{PidTagOriginalSenderSearchKey, 0x005C, PtypBinary},
-	{PidTagOriginalSensitivity, 0x002E, PtypInteger32},
-	{PidTagOriginalSentRepresentingAddressType, 0x0068, PtypString},
-	{PidTagOriginalSentRepresentingEmailAddress, 0x0069, PtypString},
-	{PidTagOriginalSentRepresentingEntryId, 0x005E, PtypBinary},
-	{PidTagOriginalSentRepresentingName, 0x005D, PtypString},
-	{PidTagOriginalSentRepresentingSearchKey, 0x005F, PtypBinary},
-	{PidTagOriginalSubject, 0x0049, PtypString},
-	{PidTagOriginalSubmitTime, 0x004E, PtypTime},
-	{PidTagOriginatorDeliveryReportRequested, 0x0023, PtypBoolean},
-	{PidTagOriginatorNonDeliveryReportRequested, 0x0C08, PtypBoolean},
-	{PidTagOscSyncEnabled, 0x7C24, PtypBoolean},
-	{PidTagOtherAddressCity, 0x3A5F, PtypString},
-	{PidTagOtherAddressCountry, 0x3A60, PtypString},
-	{PidTagOtherAddressPostalCode, 0x3A61, PtypString},
-	{PidTagOtherAddressPostOfficeBox, 0x3A64, PtypString},
-	{PidTagOtherAddressStateOrProvince, 0x3A62, PtypString},
-	{PidTagOtherAddressStreet, 0x3A63, PtypString},
-	{PidTagOtherTelephoneNumber, 0x3A1F, PtypString},
-	{PidTagOutOfOfficeState, 0x661D, PtypBoolean},
-	{PidTagOwnerAppointmentId, 0x0062, PtypInteger32},
-	{PidTagPagerTelephoneNumber, 0x3A21, PtypString},
-	{PidTagParentEntryId, 0x0E09, PtypBinary},
-	{PidTagParentFolderId, 0x6749, PtypInteger64},
-	{PidTagParentKey, 0x0025, PtypBinary},
-	{PidTagParentSourceKey, 0x65E1, PtypBinary},
-	{PidTagPersonalHomePage, 0x3A50, PtypString},
-	{PidTagPolicyTag, 0x3019, PtypBinary},
-	{PidTagPostalAddress, 0x3A15, PtypString},
-	{PidTagPostalCode, 0x3A2A, PtypString},
-	{PidTagPostOfficeBox, 0x3A2B, PtypString},
-	{PidTagPredecessorChangeList, 0x65E3, PtypBinary},
-	{PidTagPrimaryFaxNumber, 0x3A23, PtypString},
-	{PidTagPrimarySendAccount, 0x0E28, PtypString},
-	{PidTagPrimaryTelephoneNumber, 0x3A1A, PtypString},
-	{PidTagPriority, 0x0026, PtypInteger32},
-	{PidTagProcessed, 0x7D01, PtypBoolean},
-	{PidTagProfession, 0x3A46, PtypString},
-	{PidTagProhibitReceiveQuota, 0x666A, PtypInteger32},
-	{PidTagProhibitSendQuota, 0x666E, PtypInteger32},
-	{PidTagPurportedSenderDomain, 0x4083, PtypString},
-	{PidTagRadioTelephoneNumber, 0x3A1D, PtypString},
-	{PidTagRead, 0x0E69, PtypBoolean},
-	{PidTagReadReceiptAddressType, 0x4029, PtypString},
-	{PidTagReadReceiptEmailAddress, 0x402A, PtypString},
-	{PidTagReadReceiptEntryId, 0x0046, PtypBinary},
-	{PidTagReadReceiptName, 0x402B, PtypString},
-	{PidTagReadReceiptRequested, 0x0029, PtypBoolean},
-	{PidTagReadReceiptSearchKey, 0x0053, PtypBinary},
-	{PidTagReadReceiptSmtpAddress, 0x5D05, PtypString},
-	{PidTagReceiptTime, 0x002A, PtypTime},
-	{PidTagReceivedByAddressType, 0x0075, PtypString},
-	{PidTagReceivedByEmailAddress, 0x0076, PtypString},
-	{PidTagReceivedByEntryId, 0x003F, PtypBinary},
-	{PidTagReceivedByName, PtypTime, PtypString},
-	{PidTagReceivedBySearchKey, 0x0051, PtypBinary},
-	{PidTagReceivedBySmtpAddress, 0x5D07, PtypString},
-	{PidTagReceivedRepresentingAddressType, 0x0077, PtypString},
-	{PidTagReceivedRepresentingEmailAddress, 0x0078, PtypString},
-	{PidTagReceivedRepresentingEntryId, 0x0043, PtypBinary},
-	{PidTagReceivedRepresentingName, 0x0044, PtypString},
-	{PidTagReceivedRepresentingSearchKey, 0x0052, PtypBinary},
-	{PidTagReceivedRepresentingSmtpAddress, 0x5D08, PtypString},
-	{PidTagRecipientDisplayName, 0x5FF6, PtypString},
-	{PidTagRecipientEntryId, 0x5FF7, PtypBinary},
-	{PidTagRecipientFlags, 0x5FFD, PtypInteger32},
-	{PidTagRecipientOrder, 0x5FDF, PtypInteger32},
-	{PidTagRecipientProposed, 0x5FE1, PtypBoolean},
-	{PidTagRecipientProposedEndTime, 0x5FE4, PtypTime},
-	{PidTagRecipientProposedStartTime, 0x5FE3, PtypTime},
-	{PidTagRecipientReassignmentProhibited, 0x002B, PtypBoolean},
-	{PidTagRecipientTrackStatus, 0x5FFF, PtypInteger32},
-	{PidTagRecipientTrackStatusTime, 0x5FFB, PtypTime},
-	{PidTagRecipientType, 0x0C15, PtypInteger32},
-	{PidTagRecordKey, 0x0FF9, PtypBinary},
-	{PidTagReferredByName, 0x3A47, PtypString},
-	{PidTagRemindersOnlineEntryId, 0x36D5, PtypBinary},
-	{PidTagRemoteMessageTransferAgent, 0x0C21, PtypString},
-	{PidTagRenderingPosition, 0x370B, PtypInteger32},
-	{PidTagReplyRecipientEntries, 0x004F, PtypBinary},
-	{PidTagReplyRecipientNames, 0x0050, PtypString},
-	{PidTagReplyRequested, 0x0C17, PtypBoolean},
-	{PidTagReplyTemplateId, 0x65C2, PtypBinary},
-	{PidTagReplyTime, 0x0030, PtypTime},
-	{PidTagReportDisposition, 0x0080, PtypString},
-	{PidTagReportDispositionMode, 0x0081, PtypString},
-	{PidTagReportEntryId, 0x0045, PtypBinary},
-	{PidTagReportingMessageTransferAgent, 0x6820, PtypString},
-	{PidTagReportName, 0x003A, PtypString},
-	{PidTagReportSearchKey, 0x0054, PtypBinary},
-	{PidTagReportTag, 0x0031, PtypBinary},
-	{PidTagReportText, 0x1001, PtypString},
-	{PidTagReportTime, 0x0032, PtypTime},
-	{PidTagResolveMethod, 0x3FE7, PtypInteger32},
-	{PidTagResponseRequested, 0x0063, PtypBoolean},
-	{PidTagResponsibility, 0x0E0F, PtypBoolean},
-	{PidTagRetentionDate, 0x301C, PtypTime},
-	{PidTagRetentionFlags, 0x301D, PtypInteger32},
-	{PidTagRetentionPeriod, 0x301A, PtypInteger32},
-	{PidTagRights, 0x6639, PtypInteger32},
-	{PidTagRoamingDatatypes, 0x7C06, PtypInteger32},
-	{PidTagRoamingDictionary, 0x7C07, PtypBinary},
-	{PidTagRoamingXmlStream, 0x7C08, PtypBinary},
-	{PidTagRowid, 0x3000, PtypInteger32},
-	{PidTagRowType, 0x0FF5, PtypInteger32},
-	{PidTagRtfCompressed, 0x1009, PtypBinary},
-	{PidTagRtfInSync, 0x0E1F, PtypBoolean},
-	{PidTagRuleActionNumber, 0x6650, PtypInteger32},
-	{PidTagRuleActions, 0x6680, PtypRuleAction},
-	{PidTagRuleActionType, 0x6649, PtypInteger32},
-	{PidTagRuleCondition, 0x6679, PtypRestriction},
-	{PidTagRuleError, 0x6648, PtypInteger32},
-	{PidTagRuleFolderEntryId, 0x6651, PtypBinary},
-	{PidTagRuleId, 0x6674, PtypInteger64},
-	{PidTagRuleIds, 0x6675, PtypBinary},
-	{PidTagRuleLevel, 0x6683, PtypInteger32},
-	{PidTagRuleMessageLevel, 0x65ED, PtypInteger32},
-	{PidTagRuleMessageName, 0x65EC, PtypString},
-	{PidTagRuleMessageProvider, 0x65EB, PtypString},
-	{PidTagRuleMessageProviderData, 0x65EE, PtypBinary},
-	{PidTagRuleMessageSequence, 0x65F3, PtypInteger32},
-	{PidTagRuleMessageState, 0x65E9, PtypInteger32},
-	{PidTagRuleMessageUserFlags, 0x65EA, PtypInteger32},
-	{PidTagRuleName, 0x6682, PtypString},
-	{PidTagRuleProvider, 0x6681, PtypString},
-	{PidTagRuleProviderData, 0x6684, PtypBinary},
-	{PidTagRuleSequence, 0x6676, PtypInteger32},
-	{PidTagRuleState, 0x6677, PtypInteger32},
-	{PidTagRuleUserFlags, 0x6678, PtypInteger32},
-	{PidTagRwRulesStream, 0x6802, PtypBinary},
-	{PidTagScheduleInfoAppointmentTombstone, 0x686A, PtypBinary},
-	{PidTagScheduleInfoAutoAcceptAppointments, 0x686D, PtypBoolean},
-	{PidTagScheduleInfoDelegateEntryIds, 0x6845, PtypMultipleBinary},
-	{PidTagScheduleInfoDelegateNames, 0x6844, PtypMultipleString},
-	{PidTagScheduleInfoDelegateNamesW, 0x684A, PtypMultipleString},
-	{PidTagScheduleInfoDelegatorWantsCopy, 0x6842, PtypBoolean},
-	{PidTagScheduleInfoDelegatorWantsInfo, 0x684B, PtypBoolean},
-	{PidTagScheduleInfoDisallowOverlappingAppts, 0x686F, PtypBoolean},
-	{PidTagScheduleInfoDisallowRecurringAppts, 0x686E, PtypBoolean},
-	{PidTagScheduleInfoDontMailDelegates, 0x6843, PtypBoolean},
-	{PidTagScheduleInfoFreeBusy, 0x686C, PtypBinary},
-	{PidTagScheduleInfoFreeBusyAway, 0x6856, PtypMultipleBinary},
-	{PidTagScheduleInfoFreeBusyBusy, 0x6854, PtypMultipleBinary},
-	{PidTagScheduleInfoFreeBusyMerged, 0x6850, PtypMultipleBinary},
-	{PidTagScheduleInfoFreeBusyTentative, 0x6852, PtypMultipleBinary},
-	{PidTagScheduleInfoMonthsAway, 0x6855, PtypMultipleInteger32},
-	{PidTagScheduleInfoMonthsBusy, 0x6853, PtypMultipleInteger32},
-	{PidTagScheduleInfoMonthsMerged, 0x684F, PtypMultipleInteger32},
-	{PidTagScheduleInfoMonthsTentative, 0x6851, PtypMultipleInteger32},
-	{PidTagScheduleInfoResourceType, 0x6841, PtypInteger32},
-	{PidTagSchedulePlusFreeBusyEntryId, 0x6622, PtypBinary},
-	{PidTagScriptData, 0x0004, PtypBinary},
-	{PidTagSearchFolderDefinition, 0x6845, PtypBinary},
-	{PidTagSearchFolderEfpFlags, 0x6848, PtypInteger32},
-	{PidTagSearchFolderExpiration, 0x683A, PtypInteger32},
-	{PidTagSearchFolderId, 0x6842, PtypBinary},
-	{PidTagSearchFolderLastUsed, 0x6834, PtypInteger32},
-	{PidTagSearchFolderRecreateInfo, 0x6844, PtypBinary},
-	{PidTagSearchFolderStorageType, 0x6846, PtypInteger32},
-	{PidTagSearchFolderTag, 0x6847, PtypInteger32},
-	{PidTagSearchFolderTemplateId, 0x6841, PtypInteger32},
-	{PidTagSearchKey, 0x300B, PtypBinary},
-	{PidTagSecurityDescriptorAsXml, 0x0E6A, 0x001},
-	{PidTagSelectable, 0x3609, PtypBoolean},
-	{PidTagSenderAddressType, 0x0C1E, PtypString},
-	{PidTagSenderEmailAddress, 0x0C1F, PtypString},
-	{PidTagSenderEntryId, 0x0C19, PtypBinary},
-	{PidTagSenderIdStatus, 0x4079, PtypInteger32},
-	{PidTagSenderName, 0x0C1A, 0x001},
-	{PidTagSenderSearchKey, 0x0C1D, PtypBinary},
-	{PidTagSenderSmtpAddress, 0x5D01, PtypString},
-	{PidTagSenderTelephoneNumber, 0x6802, PtypString},
-	{PidTagSendInternetEncoding, 0x3A71, PtypInteger32},
-	{PidTagSendRichInfo, 0x3A40, PtypBoolean},
-	{PidTagSensitivity, 0x0036, PtypInteger32},
-	{PidTagSentMailSvrEID, 0x6740, PtypServerId},
-	{PidTagSentRepresentingAddressType, 0x0064, PtypString},
-	{PidTagSentRepresentingEmailAddress, 0x0065, PtypString},
-	{PidTagSentRepresentingEntryId, 0x0041, PtypBinary},
-	{PidTagSentRepresentingFlags, 0x401A, PtypInteger32},
-	{PidTagSentRepresentingName, 0x0042, PtypString},
-	{PidTagSentRepresentingSearchKey, 0x003B, PtypBinary},
-	{PidTagSentRepresentingSmtpAddress, 0x5D02, PtypString},
-	{PidTagSerializedReplidGuidMap, 0x6638, PtypBinary},
-	{PidTagSmtpAddress, 0x39FE, PtypString},
-	{PidTagSortLocaleId, 0x6705, PtypInteger32},
-	{PidTagSourceKey, 0x65E0, PtypBinary},
-	{PidTagSpokenName, 0x8CC2, PtypBinary},
-	{PidTagSpouseName, 0x3A48, PtypString},
-	{PidTagStartDate, 0x0060, PtypTime},
-	{PidTagStartDateEtc, 0x301B, PtypBinary},
-	{PidTagStateOrProvince, 0x3A28, PtypString},
-	{PidTagStoreEntryId, 0x0FFB, PtypBinary},
-	{PidTagStoreState, 0x340E, PtypInteger32},
-	{PidTagStoreSupportMask, 0x340D, PtypInteger32},
-	{PidTagStreetAddress, 0x3A29, PtypString},
-	{PidTagSubfolders, 0x360A, PtypBoolean},
-	{PidTagSubject, 0x0037, PtypString},
-	{PidTagSubjectPrefix, 0x003D, PtypString},
-	{PidTagSupplementaryInfo, 0x0C1B, PtypString},
-	{PidTagSurname, 0x3A11, PtypString},
-	{PidTagSwappedToDoData, 0x0E2D, PtypBinary},
-	{PidTagSwappedToDoStore, 0x0E2C, PtypBinary},
-	{PidTagTargetEntryId, 0x3010, PtypBinary},
-	{PidTagTelecommunicationsDeviceForDeafTelephoneNumber, 0x3A4B, PtypString},
-	{PidTagTelexNumber, 0x3A2C, PtypMultipleBinary},
-	{PidTagTemplateData, 0x0001, PtypBinary},
-	{PidTagTemplateid, 0x3902, PtypBinary},
-	{PidTagTextAttachmentCharset, 0x371B, PtypString},
-	{PidTagThumbnailPhoto, 0x8C9E, PtypBinary},
-	{PidTagTitle, 0x3A17, PtypString},
-	{PidTagTnefCorrelationKey, 0x007F, PtypBinary},
-	{PidTagToDoItemFlags, 0x0E2B, PtypInteger32},
-	{PidTagTransmittableDisplayName, 0x3A20, PtypString},
-	{PidTagTransportMessageHeaders, 0x007D, PtypString},
-	{PidTagTrustSender, 0x0E79, PtypInteger32},
-	{PidTagUserCertificate, 0x3A22, PtypBinary},
-	{PidTagUserEntryId, 0x6619, PtypBinary},
-	{PidTagUserX509Certificate, 0x3A70, PtypMultipleBinary},
-	{PidTagViewDescriptorBinary, 0x7001, PtypBinary},
-	{PidTagViewDescriptorName, 0x7006, PtypString},
-	{PidTagViewDescriptorStrings, 0x7002, PtypString},
-	{PidTagViewDescriptorVersion, 0x7007, PtypInteger32},
-	{PidTagVoiceMessageAttachmentOrder, 0x6805, PtypString},
-	{PidTagVoiceMessageDuration, 0x6801, PtypInteger32},
-	{PidTagVoiceMessageSenderName, 0x6803, PtypString},
-	{PidTagWeddingAnniversary, 0x3A41, PtypTime},
-	{PidTagWlinkAddressBookEID, 0x6854, PtypBinary},
-	{PidTagWlinkAddressBookStoreEID, 0x6891, PtypBinary},
-	{PidTagWlinkCalendarColor, 0x6853, PtypInteger32},
-	{PidTagWlinkClientID, 0x6890, PtypBinary},
-	{PidTagWlinkEntryId, 0x684C, PtypBinary},
-	{PidTagWlinkFlags, 0x684A, PtypInteger32},
-	{PidTagWlinkFolderType, 0x684F, PtypBinary},
-	{PidTagWlinkGroupClsid, 0x6850, PtypBinary},
-	{PidTagWlinkGroupHeaderID, 0x6842, PtypBinary},
-	{PidTagWlinkGroupName, 0x6851, PtypString},
-	{PidTagWlinkOrdinal, 0x684B, PtypBinary},
-	{PidTagWlinkRecordKey, 0x684D, PtypBinary},
-	{PidTagWlinkROGroupType, 0x6892, PtypInteger32},
-	{PidTagWlinkSaveStamp, 0x6847, PtypInteger32},
-	{PidTagWlinkSection, 0x6852, PtypInteger32},
-	{PidTagWlinkStoreEntryId, 0x684E, PtypBinary},
-	{PidTagWlinkType, 0x6849, PtypInteger32},
+	{PidLidAddressBookProviderArrayType, 0x00008029, PtypInteger32, PsetAddress, ""},
+	{PidLidAddressBookProviderEmailList, 0x00008028, PtypMultipleInteger32, PsetAddress, ""},
+	{PidLidAddressCountryCode, 0x000080DD, PtypString, PsetAddress, ""},
+	{PidLidAgingDontAgeMe, 0x0000850E, PtypBoolean, PsetCommon, ""},
+	{PidLidAllAttendeesString, 0x00008238, PtypString, PsetAppointment, ""},
+	{PidLidAllowExternalCheck, 0x00008246, PtypBoolean, PsetAppointment, ""},
+	{PidLidAnniversaryEventEntryId, 0x0000804E, PtypBinary, PsetAddress, ""},
+	{PidLidAppointmentAuxiliaryFlags, 0x00008207, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentColor, 0x00008214, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentCounterProposal, 0x00008257, PtypBoolean, PsetAppointment, ""},
+	{PidLidAppointmentDuration, 0x00008213, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentEndDate, 0x00008211, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentEndTime, 0x00008210, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentEndWhole, 0x0000820E, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentLastSequence, 0x00008203, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentMessageClass, 0x00000024, PtypString, PsetMeeting, ""},
+	{PidLidAppointmentNotAllowPropose, 0x0000825A, PtypBoolean, PsetAppointment, ""},
+	{PidLidAppointmentProposalNumber, 0x00008259, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentProposedDuration, 0x00008256, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentProposedEndWhole, 0x00008251, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentProposedStartWhole, 0x00008250, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentRecur, 0x00008216, PtypBinary, PsetAppointment, ""},
+	{PidLidAppointmentReplyName, 0x00008230, PtypString, PsetAppointment, ""},
+	{PidLidAppointmentReplyTime, 0x00008220, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentSequence, 0x00008201, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentSequenceTime, 0x00008202, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentStartDate, 0x00008212, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentStartTime, 0x0000820F, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentStartWhole, 0x0000820D, PtypTime, PsetAppointment, ""},
+	{PidLidAppointmentStateFlags, 0x00008217, PtypInteger32, PsetAppointment, ""},
+	{PidLidAppointmentSubType, 0x00008215, PtypBoolean, PsetAppointment, ""},
+	{PidLidAppointmentTimeZoneDefinitionEndDisplay, 0x0000825F, PtypBinary, PsetAppointment, ""},
+	{PidLidAppointmentTimeZoneDefinitionRecur, 0x00008260, PtypBinary, PsetAppointment, ""},
+	{PidLidAppointmentTimeZoneDefinitionStartDisplay, 0x0000825E, PtypBinary, PsetAppointment, ""},
+	{PidLidAppointmentUnsendableRecipients, 0x0000825D, PtypBinary, PsetAppointment, ""},
+	{PidLidAppointmentUpdateTime, 0x00008226, PtypTime, PsetAppointment, ""},
+	{PidLidAttendeeCriticalChange, 0x00000001, PtypTime, PsetMeeting, ""},
+	{PidLidAutoFillLocation, 0x0000823A, PtypBoolean, PsetAppointment, ""},
+	{PidLidAutoLog, 0x00008025, PtypBoolean, PsetAddress, ""},
+	{PidLidAutoProcessState, 0x0000851A, PtypInteger32, PsetCommon, ""},
+	{PidLidAutoStartCheck, 0x00008244, PtypBoolean, PsetAppointment, ""},
+	{PidLidBilling, 0x00008535, PtypString, PsetCommon, ""},
+	{PidLidBirthdayEventEntryId, 0x0000804D, PtypBinary, PsetAddress, ""},
+	{PidLidBirthdayLocal, 0x000080DE, PtypTime, PsetAddress, ""},
+	{PidLidBusinessCardCardPicture, 0x00008041, PtypBinary, PsetAddress, ""},
+	{PidLidBusinessCardDisplayDefinition, 0x00008040, PtypBinary, PsetAddress, ""},
+	{PidLidBusyStatus, 0x00008205, PtypInteger32, PsetAppointment, ""},
+	{PidLidCalendarType, 0x0000001C, PtypInteger32, PsetMeeting, ""},
+	{PidLidCategories, 0x00009000, PtypMultipleString, PsetPublicStrings, ""},
+	{PidLidCcAttendeesString, 0x0000823C, PtypString, PsetAppointment, ""},
+	{PidLidChangeHighlight, 0x00008204, PtypInteger32, PsetAppointment, ""},
+	{PidLidClassification, 0x000085B6, PtypString, PsetCommon, ""},
+	{PidLidClassificationDescription, 0x000085B7, PtypString, PsetCommon, ""},
+	{PidLidClassificationGuid, 0x000085B8, PtypString, PsetCommon, ""},
+	{PidLidClassificationKeep, 0x000085BA, PtypBoolean, PsetCommon, ""},
+	{PidLidClassified, 0x000085B5, PtypBoolean, PsetCommon, ""},
+	{PidLidCleanGlobalObjectId, 0x00000023, PtypBinary, PsetMeeting, ""},
+	{PidLidClientIntent, 0x00000015, PtypInteger32, PsetCalendarAssistant, ""},
+	{PidLidClipEnd, 0x00008236, PtypTime, PsetAppointment, ""},
+	{PidLidClipStart, 0x00008235, PtypTime, PsetAppointment, ""},
+	{PidLidCollaborateDoc, 0x00008247, PtypString, PsetAppointment, ""},
+	{PidLidCommonEnd, 0x00008517, PtypTime, PsetCommon, ""},
+	{PidLidCommonStart, 0x00008516, PtypTime, PsetCommon, ""},
+	{PidLidCompanies, 0x00008539, PtypMultipleString, PsetCommon, ""},
+	{PidLidConferencingCheck, 0x00008240, PtypBoolean, PsetAppointment, ""},
+	{PidLidConferencingType, 0x00008241, PtypInteger32, PsetAppointment, ""},
+	{PidLidContactCharacterSet, 0x00008023, PtypInteger32, PsetAddress, ""},
+	{PidLidContactItemData, 0x00008007, PtypMultipleInteger32, PsetAddress, ""},
+	{PidLidContactLinkedGlobalAddressListEntryId, 0x000080E2, PtypBinary, PsetAddress, ""},
+	{PidLidContactLinkEntry, 0x00008585, PtypBinary, PsetCommon, ""},
+	{PidLidContactLinkGlobalAddressListLinkId, 0x000080E8, PtypGuid, PsetAddress, ""},
+	{PidLidContactLinkGlobalAddressListLinkState, 0x000080E6, PtypInteger32, PsetAddress, ""},
+	{PidLidContactLinkLinkRejectHistory, 0x000080E5, PtypMultipleBinary, PsetAddress, ""},
+	{PidLidContactLinkName, 0x00008586, PtypString, PsetCommon, ""},
+	{PidLidContactLinkSearchKey, 0x00008584, PtypBinary, PsetCommon, ""},
+	{PidLidContactLinkSMTPAddressCache, 0x000080E3, PtypMultipleString, PsetAddress, ""},
+	{PidLidContacts, 0x0000853A, PtypMultipleString, PsetCommon, ""},
+	{PidLidContactUserField1, 0x0000804F, PtypString, PsetAddress, ""},
+	{PidLidContactUserField2, 0x00008050, PtypString, PsetAddress, ""},
+	{PidLidContactUserField3, 0x00008051, PtypString, PsetAddress, ""},
+	{PidLidContactUserField4, 0x00008052, PtypString, PsetAddress, ""},
+	{PidLidConversationActionLastAppliedTime, 0x000085CA, PtypTime, PsetCommon, ""},
+	{PidLidConversationActionMaxDeliveryTime, 0x000085C8, PtypTime, PsetCommon, ""},
+	{PidLidConversationActionMoveFolderEid, 0x000085C6, PtypBinary, PsetCommon, ""},
+	{PidLidConversationActionMoveStoreEid, 0x000085C7, PtypBinary, PsetCommon, ""},
+	{PidLidConversationActionVersion, 0x000085CB, PtypInteger32, PsetCommon, ""},
+	{PidLidConversationProcessed, 0x000085C9, PtypInteger32, PsetCommon, ""},
+	{PidLidCurrentVersion, 0x00008552, PtypInteger32, PsetCommon, ""},
+	{PidLidCurrentVersionName, 0x00008554, PtypString, PsetCommon, ""},
+	{PidLidDayInterval, 0x00000011, PtypInteger16, PsetMeeting, ""},
+	{PidLidDayOfMonth, 0x00001000, PtypInteger32, PsetMeeting, ""},
+	{PidLidDelegateMail, 0x00000009, PtypBoolean, PsetMeeting, ""},
+	{PidLidDepartment, 0x00008010, PtypString, PsetAddress, ""},
+	{PidLidDirectory, 0x00008242, PtypString, PsetAppointment, ""},
+	{PidLidDistributionListChecksum, 0x0000804C, PtypInteger32, PsetAddress, ""},
+	{PidLidDistributionListMembers, 0x00008055, PtypMultipleBinary, PsetAddress, ""},
+	{PidLidDistributionListName, 0x00008053, PtypString, PsetAddress, ""},
+	{PidLidDistributionListOneOffMembers, 0x00008054, PtypMultipleBinary, PsetAddress, ""},
+	{PidLidDistributionListStream, 0x00008064, PtypBinary, PsetAddress, ""},
+	{PidLidEmail1AddressType, 0x00008082, PtypString, PsetAddress, ""},
+	{PidLidEmail1DisplayName, 0x00008080, PtypString, PsetAddress, ""},
+	{PidLidEmail1EmailAddress, 0x00008083, PtypString, PsetAddress, ""},
+	{PidLidEmail1OriginalDisplayName, 0x00008084, PtypString, PsetAddress, ""},
+	{PidLidEmail1OriginalEntryId, 0x00008085, PtypBinary, PsetAddress, ""},
+	{PidLidEmail2AddressType, 0x00008092, PtypString, PsetAddress, ""},
+	{PidLidEmail2DisplayName, 0x00008090, PtypString, PsetAddress, ""},
+	{PidLidEmail2EmailAddress, 0x00008093, PtypString, PsetAddress, ""},
+	{PidLidEmail2OriginalDisplayName, 0x00008094, PtypString, PsetAddress, ""},
+	{PidLidEmail2OriginalEntryId, 0x00008095, PtypBinary, PsetAddress, ""},
+	{PidLidEmail3AddressType, 0x000080A2, PtypString, PsetAddress, ""},
+	{PidLidEmail3DisplayName, 0x000080A0, PtypString, PsetAddress, ""},
+	{PidLidEmail3EmailAddress, 0x000080A3, PtypString, PsetAddress, ""},
+	{PidLidEmail3OriginalDisplayName, 0x000080A4, PtypString, PsetAddress, ""},
+	{PidLidEmail3OriginalEntryId, 0x000080A5, PtypBinary, PsetAddress, ""},
+	{PidLidEndRecurrenceDate, 0x0000000F, PtypInteger32, PsetMeeting, ""},
+	{PidLidEndRecurrenceTime, 0x00000010, PtypInteger32, PsetMeeting, ""},
+	{PidLidExceptionReplaceTime, 0x00008228, PtypTime, PsetAppointment, ""},
+	{PidLidFax1AddressType, 0x000080B2, PtypString, PsetAddress, ""},
+	{PidLidFax1EmailAddress, 0x000080B3, PtypString, PsetAddress, ""},
+	{PidLidFax1OriginalDisplayName, 0x000080B4, PtypString, PsetAddress, ""},
+	{PidLidFax1OriginalEntryId, 0x000080B5, PtypBinary, PsetAddress, ""},
+	{PidLidFax2AddressType, 0x000080C2, PtypString, PsetAddress, ""},
+	{PidLidFax2EmailAddress, 0x000080C3, PtypString, PsetAddress, ""},
+	{PidLidFax2OriginalDisplayName, 0x000080C4, PtypString, PsetAddress, ""},
+	{PidLidFax2OriginalEntryId, 0x000080C5, PtypBinary, PsetAddress, ""},
+	{PidLidFax3AddressType, 0x000080D2, PtypString, PsetAddress, ""},
+	{PidLidFax3EmailAddress, 0x000080D3, PtypString, PsetAddress, ""},
+	{PidLidFax3OriginalDisplayName, 0x000080D4, PtypString, PsetAddress, ""},
+	{PidLidFax3OriginalEntryId, 0x000080D5, PtypBinary, PsetAddress, ""},
+	{PidLidFExceptionalAttendees, 0x0000822B, PtypBoolean, PsetAppointment, ""},
+	{PidLidFExceptionalBody, 0x00008206, PtypBoolean, PsetAppointment, ""},
+	{PidLidFileUnder, 0x00008005, PtypString, PsetAddress, ""},
+	{PidLidFileUnderId, 0x00008006, PtypInteger32, PsetAddress, ""},
+	{PidLidFileUnderList, 0x00008026, PtypMultipleInteger32, PsetAddress, ""},
+	{PidLidFInvited, 0x00008229, PtypBoolean, PsetAppointment, ""},
+	{PidLidFlagRequest, 0x00008530, PtypString, PsetCommon, ""},
+	{PidLidFlagString, 0x000085C0, PtypInteger32, PsetCommon, ""},
+	{PidLidForwardInstance, 0x0000820A, PtypBoolean, PsetAppointment, ""},
+	{PidLidForwardNotificationRecipients, 0x00008261, PtypBinary, PsetAppointment, ""},
+	{PidLidFOthersAppointment, 0x0000822F, PtypBoolean, PsetAppointment, ""},
+	{PidLidFreeBusyLocation, 0x000080D8, PtypString, PsetAddress, ""},
+	{PidLidGlobalObjectId, 0x00000003, PtypBinary, PsetMeeting, ""},
+	{PidLidHasPicture, 0x00008015, PtypBoolean, PsetAddress, ""},
+	{PidLidHomeAddress, 0x0000801A, PtypString, PsetAddress, ""},
+	{PidLidHomeAddressCountryCode, 0x000080DA, PtypString, PsetAddress, ""},
+	{PidLidHtml, 0x0000802B, PtypString, PsetAddress, ""},
+	{PidLidICalendarDayOfWeekMask, 0x00001001, PtypInteger32, PsetMeeting, ""},
+	{PidLidInboundICalStream, 0x0000827A, PtypBinary, PsetAppointment, ""},
+	{PidLidInfoPathFormName, 0x000085B1, PtypString, PsetCommon, ""},
+	{PidLidInstantMessagingAddress, 0x00008062, PtypString, PsetAddress, ""},
+	{PidLidIntendedBusyStatus, 0x00008224, PtypInteger32, PsetAppointment, ""},
+	{PidLidInternetAccountName, 0x00008580, PtypString, PsetCommon, ""},
+	{PidLidInternetAccountStamp, 0x00008581, PtypString, PsetCommon, ""},
+	{PidLidIsContactLinked, 0x000080E0, PtypBoolean, PsetAddress, ""},
+	{PidLidIsException, 0x0000000A, PtypBoolean, PsetMeeting, ""},
+	{PidLidIsRecurring, 0x00000005, PtypBoolean, PsetMeeting, ""},
+	{PidLidIsSilent, 0x00000004, PtypBoolean, PsetMeeting, ""},
+	{PidLidLinkedTaskItems, 0x0000820C, PtypMultipleBinary, PsetAppointment, ""},
+	{PidLidLocation, 0x00008208, PtypString, PsetAppointment, ""},
+	{PidLidLogDocumentPosted, 0x00008711, PtypBoolean, PsetLog, ""},
+	{PidLidLogDocumentPrinted, 0x0000870E, PtypBoolean, PsetLog, ""},
+	{PidLidLogDocumentRouted, 0x00008710, PtypBoolean, PsetLog, ""},
+	{PidLidLogDocumentSaved, 0x0000870F, PtypBoolean, PsetLog, ""},
+	{PidLidLogDuration, 0x00008707, PtypInteger32, PsetLog, ""},
+	{PidLidLogEnd, 0x00008708, PtypTime, PsetLog, ""},
+	{PidLidLogFlags, 0x0000870C, PtypInteger32, PsetLog, ""},
+	{PidLidLogStart, 0x00008706, PtypTime, PsetLog, ""},
+	{PidLidLogType, 0x00008700, PtypString, PsetLog, ""},
+	{PidLidLogTypeDesc, 0x00008712, PtypString, PsetLog, ""},
+	{PidLidMeetingType, 0x00000026, PtypInteger32, PsetMeeting, ""},
+	{PidLidMeetingWorkspaceUrl, 0x00008209, PtypString, PsetAppointment, ""},
+	{PidLidMonthInterval, 0x00000013, PtypInteger16, PsetMeeting, ""},
+	{PidLidMonthOfYear, 0x00001006, PtypInteger32, PsetMeeting, ""},
+	{PidLidMonthOfYearMask, 0x00000017, PtypInteger32, PsetMeeting, ""},
+	{PidLidNetShowUrl, 0x00008248, PtypString, PsetAppointment, ""},
+	{PidLidNoEndDateFlag, 0x0000100B, PtypBoolean, PsetMeeting, ""},
+	{PidLidNonSendableBcc, 0x00008538, PtypString, PsetCommon, ""},
+	{PidLidNonSendableCc, 0x00008537, PtypString, PsetCommon, ""},
+	{PidLidNonSendableTo, 0x00008536, PtypString, PsetCommon, ""},
+	{PidLidNonSendBccTrackStatus, 0x00008545, PtypMultipleInteger32, PsetCommon, ""},
+	{PidLidNonSendCcTrackStatus, 0x00008544, PtypMultipleInteger32, PsetCommon, ""},
+	{PidLidNonSendToTrackStatus, 0x00008543, PtypMultipleInteger32, PsetCommon, ""},
+	{PidLidNoteColor, 0x00008B00, PtypInteger32, PsetNote, ""},
+	{PidLidNoteHeight, 0x00008B03, PtypInteger32, PsetNote, ""},
+	{PidLidNoteWidth, 0x00008B02, PtypInteger32, PsetNote, ""},
+	{PidLidNoteX, 0x00008B04, PtypInteger32, PsetNote, ""},
+	{PidLidNoteY, 0x00008B05, PtypInteger32, PsetNote, ""},
+	{PidLidOccurrences, 0x00001005, PtypInteger32, PsetMeeting, ""},
+	{PidLidOldLocation, 0x00000028, PtypString, PsetMeeting, ""},
+	{PidLidOldRecurrenceType, 0x00000018, PtypInteger16, PsetMeeting, ""},
+	{PidLidOldWhenEndWhole, 0x0000002A, PtypTime, PsetMeeting, ""},
+	{PidLidOldWhenStartWhole, 0x00000029, PtypTime, PsetMeeting, ""},
+	{PidLidOnlinePassword, 0x00008249, PtypString, PsetAppointment, ""},
+	{PidLidOptionalAttendees, 0x00000007, PtypString, PsetMeeting, ""},
+	{PidLidOrganizerAlias, 0x00008243, PtypString, PsetAppointment, ""},
+	{PidLidOriginalStoreEntryId, 0x00008237, PtypBinary, PsetAppointment, ""},
+	{PidLidOtherAddress, 0x0000801C, PtypString, PsetAddress, ""},
+	{PidLidOtherAddressCountryCode, 0x000080DC, PtypString, PsetAddress, ""},
+	{PidLidOwnerCriticalChange, 0x0000001A, PtypTime, PsetMeeting, ""},
+	{PidLidOwnerName, 0x0000822E, PtypString, PsetAppointment, ""},
+	{PidLidPendingStateForSiteMailboxDocument, 0x000085E0, PtypInteger32, PsetCommon, ""},
+	{PidLidPercentComplete, 0x00008102, PtypFloating64, PsetTask, ""},
+	{PidLidPostalAddressId, 0x00008022, PtypInteger32, PsetAddress, ""},
+	{PidLidPostRssChannel, 0x00008904, PtypString, PsetPostRss, ""},
+	{PidLidPostRssChannelLink, 0x00008900, PtypString, PsetPostRss, ""},
+	{PidLidPostRssItemGuid, 0x00008903, PtypString, PsetPostRss, ""},
+	{PidLidPostRssItemHash, 0x00008902, PtypInteger32, PsetPostRss, ""},
+	{PidLidPostRssItemLink, 0x00008901, PtypString, PsetPostRss, ""},
+	{PidLidPostRssItemXml, 0x00008905, PtypString, PsetPostRss, ""},
+	{PidLidPostRssSubscription, 0x00008906, PtypString, PsetPostRss, ""},
+	{PidLidPrivate, 0x00008506, PtypBoolean, PsetCommon, ""},
+	{PidLidPromptSendUpdate, 0x00008045, PtypBoolean, PsetCommon, ""},
+	{PidLidRecurrenceDuration, 0x0000100D, PtypInteger32, PsetMeeting, ""},
+	{PidLidRecurrencePattern, 0x00008232, PtypString, PsetAppointment, ""},
+	{PidLidRecurrenceType, 0x00008231, PtypInteger32, PsetAppointment, ""},
+	{PidLidRecurring, 0x00008223, PtypBoolean, PsetAppointment, ""},
+	{PidLidReferenceEntryId, 0x000085BD, PtypBinary, PsetCommon, ""},
+	{PidLidReminderDelta, 0x00008501, PtypInteger32, PsetCommon, ""},
+	{PidLidReminderFileParameter, 0x0000851F, PtypString, PsetCommon, ""},
+	{PidLidReminderOverride, 0x0000851C, PtypBoolean, PsetCommon, ""},
+	{PidLidReminderPlaySound, 0x0000851E, PtypBoolean, PsetCommon, ""},
+	{PidLidReminderSet, 0x00008503, PtypBoolean, PsetCommon, ""},
+	{PidLidReminderSignalTime, 0x00008560, PtypTime, PsetCommon, ""},
+	{PidLidReminderTime, 0x00008502, PtypTime, PsetCommon, ""},
+	{PidLidReminderTimeDate, 0x00008505, PtypTime, PsetCommon, ""},
+	{PidLidReminderTimeTime, 0x00008504, PtypTime, PsetCommon, ""},
+	{PidLidReminderType, 0x0000851D, PtypInteger32, PsetCommon, ""},
+	{PidLidRemoteStatus, 0x00008511, PtypInteger32, PsetCommon, ""},
+	{PidLidRequiredAttendees, 0x00000006, PtypString, PsetMeeting, ""},
+	{PidLidResourceAttendees, 0x00000008, PtypString, PsetMeeting, ""},
+	{PidLidResponseStatus, 0x00008218, PtypInteger32, PsetAppointment, ""},
+	{PidLidServerProcessed, 0x000085CC, PtypBoolean, PsetCommon, ""},
+	{PidLidServerProcessingActions, 0x000085CD, PtypInteger32, PsetCommon, ""},
+	{PidLidSharingAnonymity, 0x00008A19, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingBindingEntryId, 0x00008A2D, PtypBinary, PsetSharing, ""},
+	{PidLidSharingBrowseUrl, 0x00008A51, PtypString, PsetSharing, ""},
+	{PidLidSharingCapabilities, 0x00008A17, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingConfigurationUrl, 0x00008A24, PtypString, PsetSharing, ""},
+	{PidLidSharingDataRangeEnd, 0x00008A45, PtypTime, PsetSharing, ""},
+	{PidLidSharingDataRangeStart, 0x00008A44, PtypTime, PsetSharing, ""},
+	{PidLidSharingDetail, 0x00008A2B, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingExtensionXml, 0x00008A21, PtypString, PsetSharing, ""},
+	{PidLidSharingFilter, 0x00008A13, PtypBinary, PsetSharing, ""},
+	{PidLidSharingFlags, 0x00008A0A, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingFlavor, 0x00008A18, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingFolderEntryId, 0x00008A15, PtypBinary, PsetSharing, ""},
+	{PidLidSharingIndexEntryId, 0x00008A2E, PtypBinary, PsetSharing, ""},
+	{PidLidSharingInitiatorEntryId, 0x00008A09, PtypBinary, PsetSharing, ""},
+	{PidLidSharingInitiatorName, 0x00008A07, PtypString, PsetSharing, ""},
+	{PidLidSharingInitiatorSmtp, 0x00008A08, PtypString, PsetSharing, ""},
+	{PidLidSharingInstanceGuid, 0x00008A1C, PtypBinary, PsetSharing, ""},
+	{PidLidSharingLastAutoSyncTime, 0x00008A55, PtypTime, PsetSharing, ""},
+	{PidLidSharingLastSyncTime, 0x00008A1F, PtypTime, PsetSharing, ""},
+	{PidLidSharingLocalComment, 0x00008A4D, PtypString, PsetSharing, ""},
+	{PidLidSharingLocalLastModificationTime, 0x00008A23, PtypTime, PsetSharing, ""},
+	{PidLidSharingLocalName, 0x00008A0F, PtypString, PsetSharing, ""},
+	{PidLidSharingLocalPath, 0x00008A0E, PtypString, PsetSharing, ""},
+	{PidLidSharingLocalStoreUid, 0x00008A49, PtypString, PsetSharing, ""},
+	{PidLidSharingLocalType, 0x00008A14, PtypString, PsetSharing, ""},
+	{PidLidSharingLocalUid, 0x00008A10, PtypString, PsetSharing, ""},
+	{PidLidSharingOriginalMessageEntryId, 0x00008A29, PtypBinary, PsetSharing, ""},
+	{PidLidSharingParentBindingEntryId, 0x00008A5C, PtypBinary, PsetSharing, ""},
+	{PidLidSharingParticipants, 0x00008A1E, PtypString, PsetSharing, ""},
+	{PidLidSharingPermissions, 0x00008A1B, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingProviderExtension, 0x00008A0B, PtypString, PsetSharing, ""},
+	{PidLidSharingProviderGuid, 0x00008A01, PtypBinary, PsetSharing, ""},
+	{PidLidSharingProviderName, 0x00008A02, PtypString, PsetSharing, ""},
+	{PidLidSharingProviderUrl, 0x00008A03, PtypString, PsetSharing, ""},
+	{PidLidSharingRangeEnd, 0x00008A47, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingRangeStart, 0x00008A46, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingReciprocation, 0x00008A1A, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingRemoteByteSize, 0x00008A4B, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingRemoteComment, 0x00008A2F, PtypString, PsetSharing, ""},
+	{PidLidSharingRemoteCrc, 0x00008A4C, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingRemoteLastModificationTime, 0x00008A22, PtypTime, PsetSharing, ""},
+	{PidLidSharingRemoteMessageCount, 0x00008A4F, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingRemoteName, 0x00008A05, PtypString, PsetSharing, ""},
+	{PidLidSharingRemotePass, 0x00008A0D, PtypString, PsetSharing, ""},
+	{PidLidSharingRemotePath, 0x00008A04, PtypString, PsetSharing, ""},
+	{PidLidSharingRemoteStoreUid, 0x00008A48, PtypString, PsetSharing, ""},
+	{PidLidSharingRemoteType, 0x00008A1D, PtypString, PsetSharing, ""},
+	{PidLidSharingRemoteUid, 0x00008A06, PtypString, PsetSharing, ""},
+	{PidLidSharingRemoteUser, 0x00008A0C, PtypString, PsetSharing, ""},
+	{PidLidSharingRemoteVersion, 0x00008A5B, PtypString, PsetSharing, ""},
+	{PidLidSharingResponseTime, 0x00008A28, PtypTime, PsetSharing, ""},
+	{PidLidSharingResponseType, 0x00008A27, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingRoamLog, 0x00008A4E, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingStart, 0x00008A25, PtypTime, PsetSharing, ""},
+	{PidLidSharingStatus, 0x00008A00, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingStop, 0x00008A26, PtypTime, PsetSharing, ""},
+	{PidLidSharingSyncFlags, 0x00008A60, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingSyncInterval, 0x00008A2A, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingTimeToLive, 0x00008A2C, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingTimeToLiveAuto, 0x00008A56, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingWorkingHoursDays, 0x00008A42, PtypInteger32, PsetSharing, ""},
+	{PidLidSharingWorkingHoursEnd, 0x00008A41, PtypTime, PsetSharing, ""},
+	{PidLidSharingWorkingHoursStart, 0x00008A40, PtypTime, PsetSharing, ""},
+	{PidLidSharingWorkingHoursTimeZone, 0x00008A43, PtypBinary, PsetSharing, ""},
+	{PidLidSideEffects, 0x00008510, PtypInteger32, PsetCommon, ""},
+	{PidLidSingleBodyICal, 0x0000827B, PtypBoolean, PsetAppointment, ""},
+	{PidLidSmartNoAttach, 0x00008514, PtypBoolean, PsetCommon, ""},
+	{PidLidSpamOriginalFolder, 0x0000859C, PtypBinary, PsetCommon, ""},
+	{PidLidStartRecurrenceDate, 0x0000000D, PtypInteger32, PsetMeeting, ""},
+	{PidLidStartRecurrenceTime, 0x0000000E, PtypInteger32, PsetMeeting, ""},
+	{PidLidTaskAcceptanceState, 0x0000812A, PtypInteger32, PsetTask, ""},
+	{PidLidTaskAccepted, 0x00008108, PtypBoolean, PsetTask, ""},
+	{PidLidTaskActualEffort, 0x00008110, PtypInteger32, PsetTask, ""},
+	{PidLidTaskAssigner, 0x00008121, PtypString, PsetTask, ""},
+	{PidLidTaskAssigners, 0x00008117, PtypBinary, PsetTask, ""},
+	{PidLidTaskComplete, 0x0000811C, PtypBoolean, PsetTask, ""},
+	{PidLidTaskCustomFlags, 0x00008139, PtypInteger32, PsetTask, ""},
+	{PidLidTaskDateCompleted, 0x0000810F, PtypTime, PsetTask, ""},
+	{PidLidTaskDeadOccurrence, 0x00008109, PtypBoolean, PsetTask, ""},
+	{PidLidTaskDueDate, 0x00008105, PtypTime, PsetTask, ""},
+	{PidLidTaskEstimatedEffort, 0x00008111, PtypInteger32, PsetTask, ""},
+	{PidLidTaskFCreator, 0x0000811E, PtypBoolean, PsetTask, ""},
+	{PidLidTaskFFixOffline, 0x0000812C, PtypBoolean, PsetTask, ""},
+	{PidLidTaskFRecurring, 0x00008126, PtypBoolean, PsetTask, ""},
+	{PidLidTaskGlobalId, 0x00008519, PtypBinary, PsetCommon, ""},
+	{PidLidTaskHistory, 0x0000811A, PtypInteger32, PsetTask, ""},
+	{PidLidTaskLastDelegate, 0x00008125, PtypString, PsetTask, ""},
+	{PidLidTaskLastUpdate, 0x00008115, PtypTime, PsetTask, ""},
+	{PidLidTaskLastUser, 0x00008122, PtypString, PsetTask, ""},
+	{PidLidTaskMode, 0x00008518, PtypInteger32, PsetCommon, ""},
+	{PidLidTaskMultipleRecipients, 0x00008120, PtypInteger32, PsetTask, ""},
+	{PidLidTaskNoCompute, 0x00008124, PtypBoolean, PsetTask, ""},
+	{PidLidTaskOrdinal, 0x00008123, PtypInteger32, PsetTask, ""},
+	{PidLidTaskOwner, 0x0000811F, PtypString, PsetTask, ""},
+	{PidLidTaskOwnership, 0x00008129, PtypInteger32, PsetTask, ""},
+	{PidLidTaskRecurrence, 0x00008116, PtypBinary, PsetTask, ""},
+	{PidLidTaskResetReminder, 0x00008107, PtypBoolean, PsetTask, ""},
+	{PidLidTaskRole, 0x00008127, PtypString, PsetTask, ""},
+	{PidLidTaskStartDate, 0x00008104, PtypTime, PsetTask, ""},
+	{PidLidTaskState, 0x00008113, PtypInteger32, PsetTask, ""},
+	{PidLidTaskStatus, 0x00008101, PtypInteger32, PsetTask, ""},
+	{PidLidTaskStatusOnComplete, 0x00008119, PtypBoolean, PsetTask, ""},
+	{PidLidTaskUpdates, 0x0000811B, PtypBoolean, PsetTask, ""},
+	{PidLidTaskVersion, 0x00008112, PtypInteger32, PsetTask, ""},
+	{PidLidTeamTask, 0x00008103, PtypBoolean, PsetTask, ""},
+	{PidLidTimeZone, 0x0000000C, PtypInteger32, PsetMeeting, ""},
+	{PidLidTimeZoneDescription, 0x00008234, PtypString, PsetAppointment, ""},
+	{PidLidTimeZoneStruct, 0x00008233, PtypBinary, PsetAppointment, ""},
+	{PidLidToAttendeesString, 0x0000823B, PtypString, PsetAppointment, ""},
+	{PidLidToDoOrdinalDate, 0x000085A0, PtypTime, PsetCommon, ""},
+	{PidLidToDoSubOrdinal, 0x000085A1, PtypString, PsetCommon, ""},
+	{PidLidToDoTitle, 0x000085A4, PtypString, PsetCommon, ""},
+	{PidLidUseTnef, 0x00008582, PtypBoolean, PsetCommon, ""},
+	{PidLidValidFlagStringProof, 0x000085BF, PtypTime, PsetCommon, ""},
+	{PidLidVerbResponse, 0x00008524, PtypString, PsetCommon, ""},
+	{PidLidVerbStream, 0x00008520, PtypBinary, PsetCommon, ""},
+	{PidLidWeddingAnniversaryLocal, 0x000080DF, PtypTime, PsetAddress, ""},
+	{PidLidWeekInterval, 0x00000012, PtypInteger16, PsetMeeting, ""},
+	{PidLidWhere, 0x00000002, PtypString, PsetMeeting, ""},
+	{PidLidWorkAddress, 0x0000801B, PtypString, PsetAddress, ""},
+	{PidLidWorkAddressCity, 0x00008046, PtypString, PsetAddress, ""},
+	{PidLidWorkAddressCountry, 0x00008049, PtypString, PsetAddress, ""},
+	{PidLidWorkAddressCountryCode, 0x000080DB, PtypString, PsetAddress, ""},
+	{PidLidWorkAddressPostalCode, 0x00008048, PtypString, PsetAddress, ""},
+	{PidLidWorkAddressPostOfficeBox, 0x0000804A, PtypString, PsetAddress, ""},
+	{PidLidWorkAddressState, 0x00008047, PtypString, PsetAddress, ""},
+	{PidLidWorkAddressStreet, 0x00008045, PtypString, PsetAddress, ""},
+	{PidLidYearInterval, 0x00000014, PtypInteger16, PsetMeeting, ""},
+	{PidLidYomiCompanyName, 0x0000802E, PtypString, PsetAddress, ""},
+	{PidLidYomiFirstName, 0x0000802C, PtypString, PsetAddress, ""},
+	{PidLidYomiLastName, 0x0000802D, PtypString, PsetAddress, ""},
+	{PidNameAcceptLanguage, 0, PtypString, PsetInternetHeaders, "Accept-Language"},
+	{PidNameApplicationName, 0, PtypString, PsetPublicStrings, "AppName"},
+	{PidNameAttachmentMacContentType, 0, PtypString, PsetAttachment, "AttachmentMacContentType"},
+	{PidNameAttachmentMacInfo, 0, PtypBinary, PsetAttachment, "AttachmentMacInfo"},
+	{PidNameAttachmentOriginalPermissionType, 0, PtypInteger32, PsetAttachment, "AttachmentOriginalPermissionType"},
+	{PidNameAttachmentPermissionType, 0, PtypInteger32, PsetAttachment, "AttachmentPermissionType"},
+	{PidNameAttachmentProviderType, 0, PtypString, PsetAttachment, "AttachmentProviderType"},
+	{PidNameAudioNotes, 0, PtypString, PsetUnifiedMessaging, "UMAudioNotes"},
+	{PidNameAuthor, 0, PtypString, PsetPublicStrings, "Author"},
+	{PidNameAutomaticSpeechRecognitionData, 0, PtypBinary, PsetUnifiedMessaging, "AsrData"},
+	{PidNameBirthdayContactAttributionDisplayName, 0, PtypString, PsetAddress, "BirthdayContactAttributionDisplayName"},
+	{PidNameBirthdayContactEntryId, 0, PtypBinary, PsetAddress, "BirthdayContactEntryId"},
+	{PidNameBirthdayContactPersonGuid, 0, PtypBinary, PsetAddress, "BirthdayContactPersonGuid"},
+	{PidNameByteCount, 0, PtypInteger32, PsetPublicStrings, "ByteCount"},
+	{PidNameCalendarAttendeeRole, 0, PtypInteger32, PsetPublicStrings, "urn:schemas:calendar:attendeerole"},
+	{PidNameCalendarBusystatus, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:busystatus"},
+	{PidNameCalendarContact, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:contact"},
+	{PidNameCalendarContactUrl, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:contacturl"},
+	{PidNameCalendarCreated, 0, PtypTime, PsetPublicStrings, "urn:schemas:calendar:created"},
+	{PidNameCalendarDescriptionUrl, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:descriptionurl"},
+	{PidNameCalendarDuration, 0, PtypInteger32, PsetPublicStrings, "urn:schemas:calendar:duration"},
+	{PidNameCalendarExceptionDate, 0, PtypMultipleTime, PsetPublicStrings, "urn:schemas:calendar:exdate"},
+	{PidNameCalendarExceptionRule, 0, PtypMultipleString, PsetPublicStrings, "urn:schemas:calendar:exrule"},
+	{PidNameCalendarGeoLatitude, 0, PtypFloating64, PsetPublicStrings, "urn:schemas:calendar:geolatitude"},
+	{PidNameCalendarGeoLongitude, 0, PtypFloating64, PsetPublicStrings, "urn:schemas:calendar:geolongitude"},
+	{PidNameCalendarInstanceType, 0, PtypInteger32, PsetPublicStrings, "urn:schemas:calendar:instancetype"},
+	{PidNameCalendarIsOrganizer, 0, PtypBoolean, PsetPublicStrings, "urn:schemas:calendar:isorganizer"},
+	{PidNameCalendarLastModified, 0, PtypTime, PsetPublicStrings, "urn:schemas:calendar:lastmodified"},
+	{PidNameCalendarLocationUrl, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:locationurl"},
+	{PidNameCalendarMeetingStatus, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:meetingstatus"},
+	{PidNameCalendarMethod, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:method"},
+	{PidNameCalendarProductId, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:prodid"},
+	{PidNameCalendarRecurrenceIdRange, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:recurrenceidrange"},
+	{PidNameCalendarReminderOffset, 0, PtypInteger32, PsetPublicStrings, "urn:schemas:calendar:reminderoffset"},
+	{PidNameCalendarResources, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:resources"},
+	{PidNameCalendarRsvp, 0, PtypBoolean, PsetPublicStrings, "urn:schemas:calendar:rsvp"},
+	{PidNameCalendarSequence, 0, PtypInteger32, PsetPublicStrings, "urn:schemas:calendar:sequence"},
+	{PidNameCalendarTimeZone, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:timezone"},
+	{PidNameCalendarTimeZoneId, 0, PtypInteger32, PsetPublicStrings, "urn:schemas:calendar:timezoneid"},
+	{PidNameCalendarTransparent, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:transparent"},
+	{PidNameCalendarUid, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:uid"},
+	{PidNameCalendarVersion, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:version"},
+	{PidNameCategory, 0, PtypString, PsetPublicStrings, "Category"},
+	{PidNameCharacterCount, 0, PtypInteger32, PsetPublicStrings, "CharCount"},
+	{PidNameComments, 0, PtypString, PsetPublicStrings, "Comments"},
+	{PidNameCompany, 0, PtypString, PsetPublicStrings, "Company"},
+	{PidNameContentBase, 0, PtypString, PsetInternetHeaders, "Content-Base"},
+	{PidNameContentClass, 0, PtypString, PsetInternetHeaders, "Content-Class"},
+	{PidNameContentType, 0, PtypString, PsetInternetHeaders, "content-type"},
+	{PidNameCreateDateTimeReadOnly, 0, PtypTime, PsetPublicStrings, "CreateDtmRo"},
+	{PidNameCrossReference, 0, PtypString, PsetInternetHeaders, "Xref"},
+	{PidNameDavId, 0, PtypString, PsetPublicStrings, "DAV:id"},
+	{PidNameDavIsCollection, 0, PtypBoolean, PsetPublicStrings, "DAV:iscollection"},
+	{PidNameDavIsStructuredDocument, 0, PtypBoolean, PsetPublicStrings, "DAV:isstructureddocument"},
+	{PidNameDavParentName, 0, PtypString, PsetPublicStrings, "DAV:parentname"},
+	{PidNameDavUid, 0, PtypString, PsetPublicStrings, "DAV:uid"},
+	{PidNameDocumentParts, 0, PtypMultipleString, PsetPublicStrings, "DocParts"},
+	{PidNameEditTime, 0, PtypString, PsetPublicStrings, "EditTime"},
+	{PidNameExchangeIntendedBusyStatus, 0, PtypString, PsetPublicStrings, "http://schemas.microsoft.com/exchange/intendedbusystatus"},
+	{PidNameExchangeJunkEmailMoveStamp, 0, PtypInteger32, PsetPublicStrings, "http://schemas.microsoft.com/exchange/junkemailmovestamp"},
+	{PidNameExchangeModifyExceptionStructure, 0, PtypBinary, PsetPublicStrings, "http://schemas.microsoft.com/exchange/modifyexceptionstruct"},
+	{PidNameExchangeNoModifyExceptions, 0, PtypBoolean, PsetPublicStrings, "http://schemas.microsoft.com/exchange/nomodifyexceptions"},
+	{PidNameExchangePatternEnd, 0, PtypTime, PsetPublicStrings, "http://schemas.microsoft.com/exchange/patternend"},
+	{PidNameExchangePatternStart, 0, PtypTime, PsetPublicStrings, "http://schemas.microsoft.com/exchange/patternstart"},
+	{PidNameExchangeReminderInterval, 0, PtypInteger32, PsetPublicStrings, "http://schemas.microsoft.com/exchange/reminderinterval"},
+	{PidNameExchDatabaseSchema, 0, PtypMultipleString, PsetPublicStrings, "urn:schemas-microsoft-com:exch-data:baseschema"},
+	{PidNameExchDataExpectedContentClass, 0, PtypMultipleString, PsetPublicStrings, "urn:schemas-microsoft-com:exch-data:expected-content-class"},
+	{PidNameExchDataSchemaCollectionReference, 0, PtypString, PsetPublicStrings, "urn:schemas-microsoft-com:exch-data:schema-collection-ref"},
+	{PidNameExtractedAddresses, 0, PtypString, PsetXmlExtractedEntities, "XmlExtractedAddresses"},
+	{PidNameExtractedContacts, 0, PtypString, PsetXmlExtractedEntities, "XmlExtractedContacts"},
+	{PidNameExtractedEmails, 0, PtypString, PsetXmlExtractedEntities, "XmlExtractedEmails"},
+	{PidNameExtractedMeetings, 0, PtypString, PsetXmlExtractedEntities, "XmlExtractedMeetings"},
+	{PidNameExtractedPhones, 0, PtypString, PsetXmlExtractedEntities, "XmlExtractedPhones"},
+	{PidNameExtractedTasks, 0, PtypString, PsetXmlExtractedEntities, "XmlExtractedTasks"},
+	{PidNameExtractedUrls, 0, PtypString, PsetXmlExtractedEntities, "XmlExtractedUrls"},
+	{PidNameFrom, 0, PtypString, PsetInternetHeaders, "From"},
+	{PidNameHeadingPairs, 0, PtypBinary, PsetPublicStrings, "HeadingPairs"},
+	{PidNameHiddenCount, 0, PtypInteger32, PsetPublicStrings, "HiddenCount"},
+	{PidNameHttpmailCalendar, 0, PtypString, PsetPublicStrings, "urn:schemas:httpmail:calendar"},
+	{PidNameHttpmailHtmlDescription, 0, PtypString, PsetPublicStrings, "urn:schemas:httpmail:htmldescription"},
+	{PidNameHttpmailSendMessage, 0, PtypString, PsetPublicStrings, "urn:schemas:httpmail:sendmsg"},
+	{PidNameICalendarRecurrenceDate, 0, PtypMultipleTime, PsetPublicStrings, "urn:schemas:calendar:rdate"},
+	{PidNameICalendarRecurrenceRule, 0, PtypMultipleString, PsetPublicStrings, "urn:schemas:calendar:rrule"},
+	{PidNameInternetSubject, 0, PtypString, PsetInternetHeaders, "Subject"},
+	{PidNameIsBirthdayContactWritable, 0, PtypBoolean, PsetAddress, "IsBirthdayContactWritable"},
+	{PidNameKeywords, 0, PtypMultipleString, PsetPublicStrings, "Keywords"},
+	{PidNameLastAuthor, 0, PtypString, PsetPublicStrings, "LastAuthor"},
+	{PidNameLastPrinted, 0, PtypTime, PsetPublicStrings, "LastPrinted"},
+	{PidNameLastSaveDateTime, 0, PtypTime, PsetPublicStrings, "LastSaveDtm"},
+	{PidNameLineCount, 0, PtypInteger32, PsetPublicStrings, "LineCount"},
+	{PidNameLinksDirty, 0, PtypBoolean, PsetPublicStrings, "LinksDirty"},
+	{PidNameLocationUrl, 0, PtypString, PsetPublicStrings, "urn:schemas:calendar:locationurl"},
+	{PidNameManager, 0, PtypString, PsetPublicStrings, "Manager"},
+	{PidNameMeetingDoNotForward, 0, PtypBoolean, PsetPublicStrings, "DoNotForward"},
+	{PidNameMSIPLabels, 0, PtypString, PsetInternetHeaders, "msip_labels"},
+	{PidNameMultimediaClipCount, 0, PtypInteger32, PsetPublicStrings, "MMClipCount"},
+	{PidNameNoteCount, 0, PtypInteger32, PsetPublicStrings, "NoteCount"},
+	{PidNameOMSAccountGuid, 0, PtypString, PsetPublicStrings, "OMSAccountGuid"},
+	{PidNameOMSMobileModel, 0, PtypString, PsetPublicStrings, "OMSMobileModel"},
+	{PidNameOMSScheduleTime, 0, PtypTime, PsetPublicStrings, "OMSScheduleTime"},
+	{PidNameOMSServiceType, 0, PtypInteger32, PsetPublicStrings, "OMSServiceType"},
+	{PidNameOMSSourceType, 0, PtypInteger32, PsetPublicStrings, "OMSSourceType"},
+	{PidNamePageCount, 0, PtypInteger32, PsetPublicStrings, "PageCount"},
+	{PidNameParagraphCount, 0, PtypInteger32, PsetPublicStrings, "ParCount"},
+	{PidNamePhishingStamp, 0, PtypInteger32, PsetPublicStrings, "http://schemas.microsoft.com/outlook/phishingstamp"},
+	{PidNamePresentationFormat, 0, PtypString, PsetPublicStrings, "PresFormat"},
+	{PidNameQuarantineOriginalSender, 0, PtypString, PsetPublicStrings, "quarantine-original-sender"},
+	{PidNameRevisionNumber, 0, PtypString, PsetPublicStrings, "RevNumber"},
+	{PidNameRightsManagementLicense, 0, PtypMultipleBinary, PsetPublicStrings, "DRMLicense"},
+	{PidNameScale, 0, PtypBoolean, PsetPublicStrings, "Scale"},
+	{PidNameSecurity, 0, PtypInteger32, PsetPublicStrings, "Security"},
+	{PidNameSlideCount, 0, PtypInteger32, PsetPublicStrings, "SlideCount"},
+	{PidNameSubject, 0, PtypString, PsetPublicStrings, "Subject"},
+	{PidNameTemplate, 0, PtypString, PsetPublicStrings, "Template"},
+	{PidNameThumbnail, 0, PtypBinary, PsetPublicStrings, "Thumbnail"},
+	{PidNameTitle, 0, PtypString, PsetPublicStrings, "Title"},
+	{PidNameWordCount, 0, PtypInteger32, PsetPublicStrings, "WordCount"},
+	{PidNameXCallId, 0, PtypString, PsetInternetHeaders, "X-CallID"},
+	{PidNameXFaxNumberOfPages, 0, PtypInteger16, PsetInternetHeaders, "X-FaxNumberOfPages"},
+	{PidNameXRequireProtectedPlayOnPhone, 0, PtypBoolean, PsetInternetHeaders, "X-RequireProtectedPlayOnPhone"},
+	{PidNameXSenderTelephoneNumber, 0, PtypString, PsetInternetHeaders, "X-CallingTelephoneNumber"},
+	{PidNameXSharingBrowseUrl, 0, PtypString, PsetInternetHeaders, "X-Sharing-Browse-Url"},
+	{PidNameXSharingCapabilities, 0, PtypString, PsetInternetHeaders, "X-Sharing-Capabilities"},
+	{PidNameXSharingConfigUrl, 0, PtypString, PsetInternetHeaders, "X-Sharing-Config-Url"},
+	{PidNameXSharingExendedCaps, 0, PtypString, PsetInternetHeaders, "X-Sharing-Exended-Caps"},
+	{PidNameXSharingFlavor, 0, PtypString, PsetInternetHeaders, "X-Sharing-Flavor"},
+	{PidNameXSharingInstanceGuid, 0, PtypString, PsetInternetHeaders, "X-Sharing-Instance-Guid"},
+	{PidNameXSharingLocalType, 0, PtypString, PsetInternetHeaders, "X-Sharing-Local-Type"},
+	{PidNameXSharingProviderGuid, 0, PtypString, PsetInternetHeaders, "X-Sharing-Provider-Guid"},
+	{PidNameXSharingProviderName, 0, PtypString, PsetInternetHeaders, "X-Sharing-Provider-Name"},
+	{PidNameXSharingProviderUrl, 0, PtypString, PsetInternetHeaders, "X-Sharing-Provider-Url"},
+	{PidNameXSharingRemoteName, 0, PtypString, PsetInternetHeaders, "X-Sharing-Remote-Name"},
+	{PidNameXSharingRemotePath, 0, PtypString, PsetInternetHeaders, "X-Sharing-Remote-Path"},
+	{PidNameXSharingRemoteStoreUid, 0, PtypString, PsetInternetHeaders, "X-Sharing-Remote-Store-Uid"},
+	{PidNameXSharingRemoteType, 0, PtypString, PsetInternetHeaders, "X-Sharing-Remote-Type"},
+	{PidNameXSharingRemoteUid, 0, PtypString, PsetInternetHeaders, "X-Sharing-Remote-Uid"},
+	{PidNameXVoiceMessageAttachmentOrder, 0, PtypString, PsetInternetHeaders, "X-AttachmentOrder"},
+	{PidNameXVoiceMessageDuration, 0, PtypInteger16, PsetInternetHeaders, "X-VoiceMessageDuration"},
+	{PidNameXVoiceMessageSenderName, 0, PtypString, PsetInternetHeaders, "X-VoiceMessageSenderName"},
+	{PidTagAccess, 0x0FF4, PtypInteger32, 0, ""},
+	{PidTagAccessControlListData, 0x3FE0, PtypBinary, 0, ""},
+	{PidTagAccessLevel, 0x0FF7, PtypInteger32, 0, ""},
+	{PidTagAccount, 0x3A00, PtypString, 0, ""},
+	{PidTagAdditionalRenEntryIds, 0x36D8, PtypMultipleBinary, 0, ""},
+	{PidTagAdditionalRenEntryIdsEx, 0x36D9, PtypBinary, 0, ""},
+	{PidTagAddressBookAuthorizedSenders, 0x8CD8, PtypObject, 0, ""},
+	{PidTagAddressBookContainerId, 0xFFFD, PtypInteger32, 0, ""},
+	{PidTagAddressBookDeliveryContentLength, 0x806A, PtypInteger32, 0, ""},
+	{PidTagAddressBookDisplayNamePrintable, 0x39FF, PtypString, 0, ""},
+	{PidTagAddressBookDisplayTypeExtended, 0x8C93, PtypInteger32, 0, ""},
+	{PidTagAddressBookDistributionListExternalMemberCount, 0x8CE3, PtypInteger32, 0, ""},
+	{PidTagAddressBookDistributionListMemberCount, 0x8CE2, PtypInteger32, 0, ""},
+	{PidTagAddressBookDistributionListMemberSubmitAccepted, 0x8073, PtypObject, 0, ""},
+	{PidTagAddressBookDistributionListMemberSubmitRejected, 0x8CDA, PtypObject, 0, ""},
+	{PidTagAddressBookDistributionListRejectMessagesFromDLMembers, 0x8CDB, PtypObject, 0, ""},
+	{PidTagAddressBookEntryId, 0x663B, PtypBinary, 0, ""},
+	{PidTagAddressBookExtensionAttribute1, 0x802D, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute10, 0x8036, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute11, 0x8C57, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute12, 0x8C58, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute13, 0x8C59, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute14, 0x8C60, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute15, 0x8C61, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute2, 0x802E, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute3, 0x802F, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute4, 0x8030, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute5, 0x8031, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute6, 0x8032, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute7, 0x8033, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute8, 0x8034, PtypString, 0, ""},
+	{PidTagAddressBookExtensionAttribute9, 0x8035, PtypString, 0, ""},
+	{PidTagAddressBookFolderPathname, 0x8004, PtypString, 0, ""},
+	{PidTagAddressBookHierarchicalChildDepartments, 0x8C9A, PtypObject, 0, ""},
+	{PidTagAddressBookHierarchicalDepartmentMembers, 0x8C97, PtypObject, 0, ""},
+	{PidTagAddressBookHierarchicalIsHierarchicalGroup, 0x8CDD, PtypBoolean, 0, ""},
+	{PidTagAddressBookHierarchicalParentDepartment, 0x8C99, PtypObject, 0, ""},
+	{PidTagAddressBookHierarchicalRootDepartment, 0x8C98, PtypString8, 0, ""},
+	{PidTagAddressBookHierarchicalShowInDepartments, 0x8C94, PtypObject, 0, ""},
+	{PidTagAddressBookHomeMessageDatabase, 0x8006, PtypString8, 0, ""},
+	{PidTagAddressBookIsMaster, 0xFFFB, PtypBoolean, 0, ""},
+	{PidTagAddressBookIsMemberOfDistributionList, 0x8008, PtypString8, 0, ""},
+	{PidTagAddressBookManageDistributionList, 0x6704, PtypObject, 0, ""},
+	{PidTagAddressBookManager, 0x8005, PtypObject, 0, ""},
+	{PidTagAddressBookManagerDistinguishedName, 0x8005, PtypString, 0, ""},
+	{PidTagAddressBookMember, 0x8009, PtypObject, 0, ""},
+	{PidTagAddressBookMessageId, 0x674F, PtypInteger64, 0, ""},
+	{PidTagAddressBookModerationEnabled, 0x8CB5, PtypBoolean, 0, ""},
+	{PidTagAddressBookNetworkAddress, 0x8170, PtypMultipleString, 0, ""},
+	{PidTagAddressBookObjectDistinguishedName, 0x803C, PtypString, 0, ""},
+	{PidTagAddressBookObjectGuid, 0x8C6D, PtypBinary, 0, ""},
+	{PidTagAddressBookOrganizationalUnitRootDistinguishedName, 0x8CA8, PtypString, 0, ""},
+	{PidTagAddressBookOwner, 0x800C, PtypObject, 0, ""},
+	{PidTagAddressBookOwnerBackLink, 0x8024, PtypObject, 0, ""},
+	{PidTagAddressBookParentEntryId, 0xFFFC, PtypBinary, 0, ""},
+	{PidTagAddressBookPhoneticCompanyName, 0x8C91, PtypString, 0, ""},
+	{PidTagAddressBookPhoneticDepartmentName, 0x8C90, PtypString, 0, ""},
+	{PidTagAddressBookPhoneticDisplayName, 0x8C92, PtypString, 0, ""},
+	{PidTagAddressBookPhoneticGivenName, 0x8C8E, PtypString, 0, ""},
+	{PidTagAddressBookPhoneticSurname, 0x8C8F, PtypString, 0, ""},
+	{PidTagAddressBookProxyAddresses, 0x800F, PtypMultipleString, 0, ""},
+	{PidTagAddressBookPublicDelegates, 0x8015, PtypObject, 0, ""},
+	{PidTagAddressBookReports, 0x800E, PtypObject, 0, ""},
+	{PidTagAddressBookRoomCapacity, 0x0807, PtypInteger32, 0, ""},
+	{PidTagAddressBookRoomContainers, 0x8C96, PtypMultipleString, 0, ""},
+	{PidTagAddressBookRoomDescription, 0x0809, PtypString, 0, ""},
+	{PidTagAddressBookSenderHintTranslations, 0x8CAC, PtypMultipleString, 0, ""},
+	{PidTagAddressBookSeniorityIndex, 0x8CA0, PtypInteger32, 0, ""},
+	{PidTagAddressBookTargetAddress, 0x8011, PtypString, 0, ""},
+	{PidTagAddressBookUnauthorizedSenders, 0x8CD9, PtypObject, 0, ""},
+	{PidTagAddressBookX509Certificate, 0x8C6A, PtypMultipleBinary, 0, ""},
+	{PidTagAddressType, 0x3002, PtypString, 0, ""},
+	{PidTagAlternateRecipientAllowed, PtypInteger16, PtypBoolean, 0, ""},
+	{PidTagAnr, 0x360C, PtypString, 0, ""},
+	{PidTagArchiveDate, 0x301F, PtypTime, 0, ""},
+	{PidTagArchivePeriod, 0x301E, PtypInteger32, 0, ""},
+	{PidTagArchiveTag, 0x3018, PtypBinary, 0, ""},
+	{PidTagAssistant, 0x3A30, PtypString, 0, ""},
+	{PidTagAssistantTelephoneNumber, 0x3A2E, PtypString, 0, ""},
+	{PidTagAssociated, 0x67AA, PtypBoolean, 0, ""},
+	{PidTagAttachAdditionalInformation, 0x370F, PtypBinary, 0, ""},
+	{PidTagAttachContentBase, 0x3711, PtypString, 0, ""},
+	{PidTagAttachContentId, 0x3712, PtypString, 0, ""},
+	{PidTagAttachContentLocation, 0x3713, PtypString, 0, ""},
+	{PidTagAttachDataBinary, 0x3701, PtypBinary, 0, ""},
+	{PidTagAttachDataObject, 0x3701, PtypObject, 0, ""},
+	{PidTagAttachEncoding, 0x3702, PtypBinary, 0, ""},
+	{PidTagAttachExtension, 0x3703, PtypString, 0, ""},
+	{PidTagAttachFilename, 0x3704, PtypString, 0, ""},
+	{PidTagAttachFlags, 0x3714, PtypInteger32, 0, ""},
+	{PidTagAttachLongFilename, 0x3707, PtypString, 0, ""},
+	{PidTagAttachLongPathname, 0x370D, PtypString, 0, ""},
+	{PidTagAttachmentContactPhoto, 0x7FFF, PtypBoolean, 0, ""},
+	{PidTagAttachmentFlags, 0x7FFD, PtypInteger32, 0, ""},
+	{PidTagAttachmentHidden, 0x7FFE, PtypBoolean, 0, ""},
+	{PidTagAttachmentLinkId, 0x7FFA, PtypInteger32, 0, ""},
+	{PidTagAttachMethod, 0x3705, PtypInteger32, 0, ""},
+	{PidTagAttachMimeTag, 0x370E, PtypString, 0, ""},
+	{PidTagAttachNumber, 0x0E21, PtypInteger32, 0, ""},
+	{PidTagAttachPathname, 0x3708, PtypString, 0, ""},
+	{PidTagAttachPayloadClass, 0x371A, PtypString, 0, ""},
+	{PidTagAttachPayloadProviderGuidString, 0x3719, PtypString, 0, ""},
+	{PidTagAttachRendering, 0x3709, PtypBinary, 0, ""},
+	{PidTagAttachSize, 0x0E20, PtypInteger32, 0, ""},
+	{PidTagAttachTag, 0x370A, PtypBinary, 0, ""},
+	{PidTagAttachTransportName, 0x370C, PtypString, 0, ""},
+	{PidTagAttributeHidden, 0x10F4, PtypBoolean, 0, ""},
+	{PidTagAttributeReadOnly, 0x10F6, PtypBoolean, 0, ""},
+	{PidTagAutoForwardComment, 0x0004, PtypString, 0, ""},
+	{PidTagAutoForwarded, PtypFloating64, PtypBoolean, 0, ""},
+	{PidTagAutoResponseSuppress, 0x3FDF, PtypInteger32, 0, ""},
+	{PidTagBirthday, 0x3A42, PtypTime, 0, ""},
+	{PidTagBlockStatus, 0x1096, PtypInteger32, 0, ""},
+	{PidTagBody, 0x1000, PtypString, 0, ""},
+	{PidTagBodyContentId, 0x1015, PtypString, 0, ""},
+	{PidTagBodyContentLocation, 0x1014, PtypString, 0, ""},
+	{PidTagBodyHtml, 0x1013, PtypString, 0, ""},
+	{PidTagBusiness2TelephoneNumber, 0x3A1B, PtypString, 0, ""},
+	{PidTagBusiness2TelephoneNumbers, 0x3A1B, PtypMultipleString, 0, ""},
+	{PidTagBusinessFaxNumber, 0x3A24, PtypString, 0, ""},
+	{PidTagBusinessHomePage, 0x3A51, PtypString, 0, ""},
+	{PidTagBusinessTelephoneNumber, 0x3A08, PtypString, 0, ""},
+	{PidTagCallbackTelephoneNumber, 0x3A02, PtypString, 0, ""},
+	{PidTagCallId, 0x6806, PtypString, 0, ""},
+	{PidTagCarTelephoneNumber, 0x3A1E, PtypString, 0, ""},
+	{PidTagCdoRecurrenceid, 0x10C5, PtypTime, 0, ""},
+	{PidTagChangeKey, 0x65E2, PtypBinary, 0, ""},
+	{PidTagChangeNumber, 0x67A4, PtypInteger64, 0, ""},
+	{PidTagChildrensNames, 0x3A58, PtypMultipleString, 0, ""},
+	{PidTagClientActions, 0x6645, PtypBinary, 0, ""},
+	{PidTagClientSubmitTime, 0x0039, PtypTime, 0, ""},
+	{PidTagCodePageId, 0x66C3, PtypInteger32, 0, ""},
+	{PidTagComment, 0x3004, PtypString, 0, ""},
+	{PidTagCompanyMainTelephoneNumber, 0x3A57, PtypString, 0, ""},
+	{PidTagCompanyName, 0x3A16, PtypString, 0, ""},
+	{PidTagComputerNetworkName, 0x3A49, PtypString, 0, ""},
+	{PidTagConflictEntryId, 0x3FF0, PtypBinary, 0, ""},
+	{PidTagContainerClass, 0x3613, PtypString, 0, ""},
+	{PidTagContainerContents, 0x360F, PtypObject, 0, ""},
+	{PidTagContainerFlags, 0x3600, PtypInteger32, 0, ""},
+	{PidTagContainerHierarchy, 0x360E, PtypObject, 0, ""},
+	{PidTagContentCount, 0x3602, PtypInteger32, 0, ""},
+	{PidTagContentFilterSpamConfidenceLevel, 0x4076, PtypInteger32, 0, ""},
+	{PidTagContentUnreadCount, 0x3603, PtypInteger32, 0, ""},
+	{PidTagConversationId, 0x3013, PtypBinary, 0, ""},
+	{PidTagConversationIndex, 0x0071, PtypBinary, 0, ""},
+	{PidTagConversationIndexTracking, 0x3016, PtypBoolean, 0, ""},
+	{PidTagConversationTopic, 0x0070, PtypString, 0, ""},
+	{PidTagCountry, 0x3A26, PtypString, 0, ""},
+	{PidTagCreationTime, 0x3007, PtypTime, 0, ""},
+	{PidTagCreatorEntryId, 0x3FF9, PtypBinary, 0, ""},
+	{PidTagCreatorName, 0x3FF8, PtypString, 0, ""},
+	{PidTagCustomerId, 0x3A4A, PtypString, 0, ""},
+	{PidTagDamBackPatched, 0x6647, PtypBoolean, 0, ""},
+	{PidTagDamOriginalEntryId, 0x6646, PtypBinary, 0, ""},
+	{PidTagDefaultPostMessageClass, 0x36E5, PtypString, 0, ""},
+	{PidTagDeferredActionMessageOriginalEntryId, 0x6741, PtypServerId, 0, ""},
+	{PidTagDeferredDeliveryTime, 0x000F, PtypTime, 0, ""},
+	{PidTagDeferredSendNumber, 0x3FEB, PtypInteger32, 0, ""},
+	{PidTagDeferredSendTime, 0x3FEF, PtypTime, 0, ""},
+	{PidTagDeferredSendUnits, 0x3FEC, PtypInteger32, 0, ""},
+	{PidTagDelegatedByRule, 0x3FE3, PtypBoolean, 0, ""},
+	{PidTagDelegateFlags, 0x686B, PtypMultipleInteger32, 0, ""},
+	{PidTagDeleteAfterSubmit, 0x0E01, PtypBoolean, 0, ""},
+	{PidTagDeletedCountTotal, 0x670B, PtypInteger32, 0, ""},
+	{PidTagDeletedOn, 0x668F, PtypTime, 0, ""},
+	{PidTagDeliverTime, 0x0010, PtypTime, 0, ""},
+	{PidTagDepartmentName, 0x3A18, PtypString, 0, ""},
+	{PidTagDepth, 0x3005, PtypInteger32, 0, ""},
+	{PidTagDisplayBcc, 0x0E02, PtypString, 0, ""},
+	{PidTagDisplayCc, 0x0E03, PtypString, 0, ""},
+	{PidTagDisplayName, 0x3001, PtypString, 0, ""},
+	{PidTagDisplayNamePrefix, 0x3A45, PtypString, 0, ""},
+	{PidTagDisplayTo, 0x0E04, PtypString, 0, ""},
+	{PidTagDisplayType, 0x3900, PtypInteger32, 0, ""},
+	{PidTagDisplayTypeEx, 0x3905, PtypInteger32, 0, ""},
+	{PidTagEmailAddress, 0x3003, PtypString, 0, ""},
+	{PidTagEndDate, 0x0061, PtypTime, 0, ""},
+	{PidTagEntryId, 0x0FFF, PtypBinary, 0, ""},
+	{PidTagExceptionEndTime, 0x7FFC, PtypTime, 0, ""},
+	{PidTagExceptionReplaceTime, 0x7FF9, PtypTime, 0, ""},
+	{PidTagExceptionStartTime, 0x7FFB, PtypTime, 0, ""},
+	{PidTagExchangeNTSecurityDescriptor, 0x0E84, PtypBinary, 0, ""},
+	{PidTagExpiryNumber, 0x3FED, PtypInteger32, 0, ""},
+	{PidTagExpiryTime, 0x0015, PtypTime, 0, ""},
+	{PidTagExpiryUnits, 0x3FEE, PtypInteger32, 0, ""},
+	{PidTagExtendedFolderFlags, 0x36DA, PtypBinary, 0, ""},
+	{PidTagExtendedRuleMessageActions, 0x0E99, PtypBinary, 0, ""},
+	{PidTagExtendedRuleMessageCondition, 0x0E9A, PtypBinary, 0, ""},
+	{PidTagExtendedRuleSizeLimit, 0x0E9B, PtypInteger32, 0, ""},
+	{PidTagFaxNumberOfPages, 0x6804, PtypInteger32, 0, ""},
+	{PidTagFlagCompleteTime, 0x1091, PtypTime, 0, ""},
+	{PidTagFlagStatus, 0x1090, PtypInteger32, 0, ""},
+	{PidTagFlatUrlName, 0x670E, PtypString, 0, ""},
+	{PidTagFolderAssociatedContents, 0x3610, PtypObject, 0, ""},
+	{PidTagFolderId, 0x6748, PtypInteger64, 0, ""},
+	{PidTagFolderFlags, 0x66A8, PtypInteger32, 0, ""},
+	{PidTagFolderType, 0x3601, PtypInteger32, 0, ""},
+	{PidTagFollowupIcon, 0x1095, PtypInteger32, 0, ""},
+	{PidTagFreeBusyCountMonths, 0x6869, PtypInteger32, 0, ""},
+	{PidTagFreeBusyEntryIds, 0x36E4, PtypMultipleBinary, 0, ""},
+	{PidTagFreeBusyMessageEmailAddress, 0x6849, PtypString, 0, ""},
+	{PidTagFreeBusyPublishEnd, 0x6848, PtypInteger32, 0, ""},
+	{PidTagFreeBusyPublishStart, 0x6847, PtypInteger32, 0, ""},
+	{PidTagFreeBusyRangeTimestamp, 0x6868, PtypTime, 0, ""},
+	{PidTagFtpSite, 0x3A4C, PtypString, 0, ""},
+	{PidTagGatewayNeedsToRefresh, 0x6846, PtypBoolean, 0, ""},
+	{PidTagGender, 0x3A4D, PtypInteger16, 0, ""},
+	{PidTagGeneration, 0x3A05, PtypString, 0, ""},
+	{PidTagGivenName, 0x3A06, PtypString, 0, ""},
+	{PidTagGovernmentIdNumber, 0x3A07, PtypString, 0, ""},
+	{PidTagHasAttachments, 0x0E1B, PtypBoolean, 0, ""},
+	{PidTagHasDeferredActionMessages, 0x3FEA, PtypBoolean, 0, ""},
+	{PidTagHasNamedProperties, 0x664A, PtypBoolean, 0, ""},
+	{PidTagHasRules, 0x663A, PtypBoolean, 0, ""},
+	{PidTagHierarchyChangeNumber, 0x663E, PtypInteger32, 0, ""},
+	{PidTagHierRev, 0x4082, PtypTime, 0, ""},
+	{PidTagHobbies, 0x3A43, PtypString, 0, ""},
+	{PidTagHome2TelephoneNumber, 0x3A2F, PtypString, 0, ""},
+	{PidTagHome2TelephoneNumbers, 0x3A2F, PtypMultipleString, 0, ""},
+	{PidTagHomeAddressCity, 0x3A59, PtypString, 0, ""},
+	{PidTagHomeAddressCountry, 0x3A5A, PtypString, 0, ""},
+	{PidTagHomeAddressPostalCode, 0x3A5B, PtypString, 0, ""},
+	{PidTagHomeAddressPostOfficeBox, 0x3A5E, PtypString, 0, ""},
+	{PidTagHomeAddressStateOrProvince, 0x3A5C, PtypString, 0, ""},
+	{PidTagHomeAddressStreet, 0x3A5D, PtypString, 0, ""},
+	{PidTagHomeFaxNumber, 0x3A25, PtypString, 0, ""},
+	{PidTagHomeTelephoneNumber, 0x3A09, PtypString, 0, ""},
+	{PidTagHtml, 0x1013, PtypBinary, 0, ""},
+	{PidTagICalendarEndTime, 0x10C4, PtypTime, 0, ""},
+	{PidTagICalendarReminderNextTime, 0x10CA, PtypTime, 0, ""},
+	{PidTagICalendarStartTime, 0x10C3, PtypTime, 0, ""},
+	{PidTagIconIndex, 0x1080, PtypInteger32, 0, ""},
+	{PidTagImportance, 0x0017, PtypInteger32, 0, ""},
+	{PidTagInConflict, 0x666C, PtypBoolean, 0, ""},
+	{PidTagInitialDetailsPane, 0x3F08, PtypInteger32, 0, ""},
+	{PidTagInitials, 0x3A0A, PtypString, 0, ""},
+	{PidTagInReplyToId, 0x1042, PtypString, 0, ""},
+	{PidTagInstanceKey, 0x0FF6, PtypBinary, 0, ""},
+	{PidTagInstanceNum, 0x674E, PtypInteger32, 0, ""},
+	{PidTagInstID, 0x674D, PtypInteger64, 0, ""},
+	{PidTagInternetCodepage, 0x3FDE, PtypInteger32, 0, ""},
+	{PidTagInternetMailOverrideFormat, 0x5902, PtypInteger32, 0, ""},
+	{PidTagInternetMessageId, 0x1035, PtypString, 0, ""},
+	{PidTagInternetReferences, 0x1039, PtypString, 0, ""},
+	{PidTagIpmAppointmentEntryId, 0x36D0, PtypBinary, 0, ""},
+	{PidTagIpmContactEntryId, 0x36D1, PtypBinary, 0, ""},
+	{PidTagIpmDraftsEntryId, 0x36D7, PtypBinary, 0, ""},
+	{PidTagIpmJournalEntryId, 0x36D2, PtypBinary, 0, ""},
+	{PidTagIpmNoteEntryId, 0x36D3, PtypBinary, 0, ""},
+	{PidTagIpmTaskEntryId, 0x36D4, PtypBinary, 0, ""},
+	{PidTagIsdnNumber, 0x3A2D, PtypString, 0, ""},
+	{PidTagJunkAddRecipientsToSafeSendersList, 0x6103, PtypInteger32, 0, ""},
+	{PidTagJunkIncludeContacts, 0x6100, PtypInteger32, 0, ""},
+	{PidTagJunkPermanentlyDelete, 0x6102, PtypInteger32, 0, ""},
+	{PidTagJunkPhishingEnableLinks, 0x6107, PtypBoolean, 0, ""},
+	{PidTagJunkThreshold, 0x6101, PtypInteger32, 0, ""},
+	{PidTagKeyword, 0x3A0B, PtypString, 0, ""},
+	{PidTagLanguage, 0x3A0C, PtypString, 0, ""},
+	{PidTagLastModificationTime, 0x3008, PtypTime, 0, ""},
+	{PidTagLastModifierEntryId, 0x3FFB, PtypBinary, 0, ""},
+	{PidTagLastModifierName, 0x3FFA, PtypString, 0, ""},
+	{PidTagLastVerbExecuted, 0x1081, PtypInteger32, 0, ""},
+	{PidTagLastVerbExecutionTime, 0x1082, PtypTime, 0, ""},
+	{PidTagListHelp, 0x1043, PtypString, 0, ""},
+	{PidTagListSubscribe, 0x1044, PtypString, 0, ""},
+	{PidTagListUnsubscribe, 0x1045, PtypString, 0, ""},
+	{PidTagLocalCommitTime, 0x6709, PtypTime, 0, ""},
+	{PidTagLocalCommitTimeMax, 0x670A, PtypTime, 0, ""},
+	{PidTagLocaleId, 0x66A1, PtypInteger32, 0, ""},
+	{PidTagLocality, 0x3A27, PtypString, 0, ""},
+	{PidTagLocation, 0x3A0D, PtypString, 0, ""},
+	{PidTagMailboxOwnerEntryId, 0x661B, PtypBinary, 0, ""},
+	{PidTagMailboxOwnerName, 0x661C, PtypString, 0, ""},
+	{PidTagManagerName, 0x3A4E, PtypString, 0, ""},
+	{PidTagMappingSignature, 0x0FF8, PtypBinary, 0, ""},
+	{PidTagMaximumSubmitMessageSize, 0x666D, PtypInteger32, 0, ""},
+	{PidTagMemberId, 0x6671, PtypInteger64, 0, ""},
+	{PidTagMemberName, 0x6672, PtypString, 0, ""},
+	{PidTagMemberRights, 0x6673, PtypInteger32, 0, ""},
+	{PidTagMessageAttachments, 0x0E13, PtypObject, 0, ""},
+	{PidTagMessageCcMe, 0x0058, PtypBoolean, 0, ""},
+	{PidTagMessageClass, 0x001A, PtypString, 0, ""},
+	{PidTagMessageCodepage, 0x3FFD, PtypInteger32, 0, ""},
+	{PidTagMessageDeliveryTime, 0x0E06, PtypTime, 0, ""},
+	{PidTagMessageEditorFormat, 0x5909, PtypInteger32, 0, ""},
+	{PidTagMessageFlags, 0x0E07, PtypInteger32, 0, ""},
+	{PidTagMessageHandlingSystemCommonName, 0x3A0F, PtypString, 0, ""},
+	{PidTagMessageLocaleId, 0x3FF1, PtypInteger32, 0, ""},
+	{PidTagMessageRecipientMe, 0x0059, PtypBoolean, 0, ""},
+	{PidTagMessageRecipients, 0x0E12, PtypObject, 0, ""},
+	{PidTagMessageSize, 0x0E08, PtypInteger32, 0, ""},
+	{PidTagMessageSizeExtended, 0x0E08, PtypInteger64, 0, ""},
+	{PidTagMessageStatus, 0x0E17, PtypInteger32, 0, ""},
+	{PidTagMessageSubmissionId, 0x0047, PtypBinary, 0, ""},
+	{PidTagMessageToMe, 0x0057, PtypBoolean, 0, ""},
+	{PidTagMid, 0x674A, PtypInteger64, 0, ""},
+	{PidTagMiddleName, 0x3A44, PtypString, 0, ""},
+	{PidTagMimeSkeleton, 0x64F0, PtypBinary, 0, ""},
+	{PidTagMobileTelephoneNumber, 0x3A1C, PtypString, 0, ""},
+	{PidTagNativeBody, 0x1016, PtypInteger32, 0, ""},
+	{PidTagNextSendAcct, 0x0E29, PtypString, 0, ""},
+	{PidTagNickname, 0x3A4F, PtypString, 0, ""},
+	{PidTagNonDeliveryReportDiagCode, 0x0C05, PtypInteger32, 0, ""},
+	{PidTagNonDeliveryReportReasonCode, 0x0C04, PtypInteger32, 0, ""},
+	{PidTagNonDeliveryReportStatusCode, 0x0C20, PtypInteger32, 0, ""},
+	{PidTagNonReceiptNotificationRequested, 0x0C06, PtypBoolean, 0, ""},
+	{PidTagNormalizedSubject, 0x0E1D, PtypString, 0, ""},
+	{PidTagObjectType, 0x0FFE, PtypInteger32, 0, ""},
+	{PidTagOfficeLocation, 0x3A19, PtypString, 0, ""},
+	{PidTagOfflineAddressBookContainerGuid, 0x6802, PtypString8, 0, ""},
+	{PidTagOfflineAddressBookDistinguishedName, 0x6804, PtypString8, 0, ""},
+	{PidTagOfflineAddressBookMessageClass, 0x6803, PtypInteger32, 0, ""},
+	{PidTagOfflineAddressBookName, 0x6800, PtypString, 0, ""},
+	{PidTagOfflineAddressBookSequence, 0x6801, PtypInteger32, 0, ""},
+	{PidTagOfflineAddressBookTruncatedProperties, 0x6805, PtypMultipleInteger32, 0, ""},
+	{PidTagOrdinalMost, 0x36E2, PtypInteger32, 0, ""},
+	{PidTagOrganizationalIdNumber, 0x3A10, PtypString, 0, ""},
+	{PidTagOriginalAuthorEntryId, 0x004C, PtypBinary, 0, ""},
+	{PidTagOriginalAuthorName, 0x004D, PtypString, 0, ""},
+	{PidTagOriginalDeliveryTime, 0x0055, PtypTime, 0, ""},
+	{PidTagOriginalDisplayBcc, 0x0072, PtypString, 0, ""},
+	{PidTagOriginalDisplayCc, 0x0073, PtypString, 0, ""},
+	{PidTagOriginalDisplayTo, 0x0074, PtypString, 0, ""},
+	{PidTagOriginalEntryId, 0x3A12, PtypBinary, 0, ""},
+	{PidTagOriginalMessageClass, 0x004B, PtypString, 0, ""},
+	{PidTagOriginalMessageId, 0x1046, PtypString, 0, ""},
+	{PidTagOriginalSenderAddressType, 0x0066, PtypString, 0, ""},
+	{PidTagOriginalSenderEmailAddress, 0x0067, PtypString, 0, ""},
+	{PidTagOriginalSenderEntryId, 0x005B, PtypBinary, 0, ""},
+	{PidTagOriginalSenderName, 0x005A, PtypString, 0, ""},
+	{PidTagOriginalSenderSearchKey, 0x005C, PtypBinary, 0, ""},
+	{PidTagOriginalSensitivity, 0x002E, PtypInteger32, 0, ""},
+	{PidTagOriginalSentRepresentingAddressType, 0x0068, PtypString, 0, ""},
+	{PidTagOriginalSentRepresentingEmailAddress, 0x0069, PtypString, 0, ""},
+	{PidTagOriginalSentRepresentingEntryId, 0x005E, PtypBinary, 0, ""},
+	{PidTagOriginalSentRepresentingName, 0x005D, PtypString, 0, ""},
+	{PidTagOriginalSentRepresentingSearchKey, 0x005F, PtypBinary, 0, ""},
+	{PidTagOriginalSubject, 0x0049, PtypString, 0, ""},
+	{PidTagOriginalSubmitTime, 0x004E, PtypTime, 0, ""},
+	{PidTagOriginatorDeliveryReportRequested, 0x0023, PtypBoolean, 0, ""},
+	{PidTagOriginatorNonDeliveryReportRequested, 0x0C08, PtypBoolean, 0, ""},
+	{PidTagOscSyncEnabled, 0x7C24, PtypBoolean, 0, ""},
+	{PidTagOtherAddressCity, 0x3A5F, PtypString, 0, ""},
+	{PidTagOtherAddressCountry, 0x3A60, PtypString, 0, ""},
+	{PidTagOtherAddressPostalCode, 0x3A61, PtypString, 0, ""},
+	{PidTagOtherAddressPostOfficeBox, 0x3A64, PtypString, 0, ""},
+	{PidTagOtherAddressStateOrProvince, 0x3A62, PtypString, 0, ""},
+	{PidTagOtherAddressStreet, 0x3A63, PtypString, 0, ""},
+	{PidTagOtherTelephoneNumber, 0x3A1F, PtypString, 0, ""},
+	{PidTagOutOfOfficeState, 0x661D, PtypBoolean, 0, ""},
+	{PidTagOwnerAppointmentId, 0x0062, PtypInteger32, 0, ""},
+	{PidTagPagerTelephoneNumber, 0x3A21, PtypString, 0, ""},
+	{PidTagParentEntryId, 0x0E09, PtypBinary, 0, ""},
+	{PidTagParentFolderId, 0x6749, PtypInteger64, 0, ""},
+	{PidTagParentKey, 0x0025, PtypBinary, 0, ""},
+	{PidTagParentSourceKey, 0x65E1, PtypBinary, 0, ""},
+	{PidTagPersonalHomePage, 0x3A50, PtypString, 0, ""},
+	{PidTagPolicyTag, 0x3019, PtypBinary, 0, ""},
+	{PidTagPostalAddress, 0x3A15, PtypString, 0, ""},
+	{PidTagPostalCode, 0x3A2A, PtypString, 0, ""},
+	{PidTagPostOfficeBox, 0x3A2B, PtypString, 0, ""},
+	{PidTagPredecessorChangeList, 0x65E3, PtypBinary, 0, ""},
+	{PidTagPrimaryFaxNumber, 0x3A23, PtypString, 0, ""},
+	{PidTagPrimarySendAccount, 0x0E28, PtypString, 0, ""},
+	{PidTagPrimaryTelephoneNumber, 0x3A1A, PtypString, 0, ""},
+	{PidTagPriority, 0x0026, PtypInteger32, 0, ""},
+	{PidTagProcessed, 0x7D01, PtypBoolean, 0, ""},
+	{PidTagProfession, 0x3A46, PtypString, 0, ""},
+	{PidTagProhibitReceiveQuota, 0x666A, PtypInteger32, 0, ""},
+	{PidTagProhibitSendQuota, 0x666E, PtypInteger32, 0, ""},
+	{PidTagPurportedSenderDomain, 0x4083, PtypString, 0, ""},
+	{PidTagRadioTelephoneNumber, 0x3A1D, PtypString, 0, ""},
+	{PidTagRead, 0x0E69, PtypBoolean, 0, ""},
+	{PidTagReadReceiptAddressType, 0x4029, PtypString, 0, ""},
+	{PidTagReadReceiptEmailAddress, 0x402A, PtypString, 0, ""},
+	{PidTagReadReceiptEntryId, 0x0046, PtypBinary, 0, ""},
+	{PidTagReadReceiptName, 0x402B, PtypString, 0, ""},
+	{PidTagReadReceiptRequested, 0x0029, PtypBoolean, 0, ""},
+	{PidTagReadReceiptSearchKey, 0x0053, PtypBinary, 0, ""},
+	{PidTagReadReceiptSmtpAddress, 0x5D05, PtypString, 0, ""},
+	{PidTagReceiptTime, 0x002A, PtypTime, 0, ""},
+	{PidTagReceivedByAddressType, 0x0075, PtypString, 0, ""},
+	{PidTagReceivedByEmailAddress, 0x0076, PtypString, 0, ""},
+	{PidTagReceivedByEntryId, 0x003F, PtypBinary, 0, ""},
+	{PidTagReceivedByName, PtypTime, PtypString, 0, ""},
+	{PidTagReceivedBySearchKey, 0x0051, PtypBinary, 0, ""},
+	{PidTagReceivedBySmtpAddress, 0x5D07, PtypString, 0, ""},
+	{PidTagReceivedRepresentingAddressType, 0x0077, PtypString, 0, ""},
+	{PidTagReceivedRepresentingEmailAddress, 0x0078, PtypString, 0, ""},
+	{PidTagReceivedRepresentingEntryId, 0x0043, PtypBinary, 0, ""},
+	{PidTagReceivedRepresentingName, 0x0044, PtypString, 0, ""},
+	{PidTagReceivedRepresentingSearchKey, 0x0052, PtypBinary, 0, ""},
+	{PidTagReceivedRepresentingSmtpAddress, 0x5D08, PtypString, 0, ""},
+	{PidTagRecipientDisplayName, 0x5FF6, PtypString, 0, ""},
+	{PidTagRecipientEntryId, 0x5FF7, PtypBinary, 0, ""},
+	{PidTagRecipientFlags, 0x5FFD, PtypInteger32, 0, ""},
+	{PidTagRecipientOrder, 0x5FDF, PtypInteger32, 0, ""},
+	{PidTagRecipientProposed, 0x5FE1, PtypBoolean, 0, ""},
+	{PidTagRecipientProposedEndTime, 0x5FE4, PtypTime, 0, ""},
+	{PidTagRecipientProposedStartTime, 0x5FE3, PtypTime, 0, ""},
+	{PidTagRecipientReassignmentProhibited, 0x002B, PtypBoolean, 0, ""},
+	{PidTagRecipientTrackStatus, 0x5FFF, PtypInteger32, 0, ""},
+	{PidTagRecipientTrackStatusTime, 0x5FFB, PtypTime, 0, ""},
+	{PidTagRecipientType, 0x0C15, PtypInteger32, 0, ""},
+	{PidTagRecordKey, 0x0FF9, PtypBinary, 0, ""},
+	{PidTagReferredByName, 0x3A47, PtypString, 0, ""},
+	{PidTagRemindersOnlineEntryId, 0x36D5, PtypBinary, 0, ""},
+	{PidTagRemoteMessageTransferAgent, 0x0C21, PtypString, 0, ""},
+	{PidTagRenderingPosition, 0x370B, PtypInteger32, 0, ""},
+	{PidTagReplyRecipientEntries, 0x004F, PtypBinary, 0, ""},
+	{PidTagReplyRecipientNames, 0x0050, PtypString, 0, ""},
+	{PidTagReplyRequested, 0x0C17, PtypBoolean, 0, ""},
+	{PidTagReplyTemplateId, 0x65C2, PtypBinary, 0, ""},
+	{PidTagReplyTime, 0x0030, PtypTime, 0, ""},
+	{PidTagReportDisposition, 0x0080, PtypString, 0, ""},
+	{PidTagReportDispositionMode, 0x0081, PtypString, 0, ""},
+	{PidTagReportEntryId, 0x0045, PtypBinary, 0, ""},
+	{PidTagReportingMessageTransferAgent, 0x6820, PtypString, 0, ""},
+	{PidTagReportName, 0x003A, PtypString, 0, ""},
+	{PidTagReportSearchKey, 0x0054, PtypBinary, 0, ""},
+	{PidTagReportTag, 0x0031, PtypBinary, 0, ""},
+	{PidTagReportText, 0x1001, PtypString, 0, ""},
+	{PidTagReportTime, 0x0032, PtypTime, 0, ""},
+	{PidTagResolveMethod, 0x3FE7, PtypInteger32, 0, ""},
+	{PidTagResponseRequested, 0x0063, PtypBoolean, 0, ""},
+	{PidTagResponsibility, 0x0E0F, PtypBoolean, 0, ""},
+	{PidTagRetentionDate, 0x301C, PtypTime, 0, ""},
+	{PidTagRetentionFlags, 0x301D, PtypInteger32, 0, ""},
+	{PidTagRetentionPeriod, 0x301A, PtypInteger32, 0, ""},
+	{PidTagRights, 0x6639, PtypInteger32, 0, ""},
+	{PidTagRoamingDatatypes, 0x7C06, PtypInteger32, 0, ""},
+	{PidTagRoamingDictionary, 0x7C07, PtypBinary, 0, ""},
+	{PidTagRoamingXmlStream, 0x7C08, PtypBinary, 0, ""},
+	{PidTagRowid, 0x3000, PtypInteger32, 0, ""},
+	{PidTagRowType, 0x0FF5, PtypInteger32, 0, ""},
+	{PidTagRtfCompressed, 0x1009, PtypBinary, 0, ""},
+	{PidTagRtfInSync, 0x0E1F, PtypBoolean, 0, ""},
+	{PidTagRuleActionNumber, 0x6650, PtypInteger32, 0, ""},
+	{PidTagRuleActions, 0x6680, PtypRuleAction, 0, ""},
+	{PidTagRuleActionType, 0x6649, PtypInteger32, 0, ""},
+	{PidTagRuleCondition, 0x6679, PtypRestriction, 0, ""},
+	{PidTagRuleError, 0x6648, PtypInteger32, 0, ""},
+	{PidTagRuleFolderEntryId, 0x6651, PtypBinary, 0, ""},
+	{PidTagRuleId, 0x6674, PtypInteger64, 0, ""},
+	{PidTagRuleIds, 0x6675, PtypBinary, 0, ""},
+	{PidTagRuleLevel, 0x6683, PtypInteger32, 0, ""},
+	{PidTagRuleMessageLevel, 0x65ED, PtypInteger32, 0, ""},
+	{PidTagRuleMessageName, 0x65EC, PtypString, 0, ""},
+	{PidTagRuleMessageProvider, 0x65EB, PtypString, 0, ""},
+	{PidTagRuleMessageProviderData, 0x65EE, PtypBinary, 0, ""},
+	{PidTagRuleMessageSequence, 0x65F3, PtypInteger32, 0, ""},
+	{PidTagRuleMessageState, 0x65E9, PtypInteger32, 0, ""},
+	{PidTagRuleMessageUserFlags, 0x65EA, PtypInteger32, 0, ""},
+	{PidTagRuleName, 0x6682, PtypString, 0, ""},
+	{PidTagRuleProvider, 0x6681, PtypString, 0, ""},
+	{PidTagRuleProviderData, 0x6684, PtypBinary, 0, ""},
+	{PidTagRuleSequence, 0x6676, PtypInteger32, 0, ""},
+	{PidTagRuleState, 0x6677, PtypInteger32, 0, ""},
+	{PidTagRuleUserFlags, 0x6678, PtypInteger32, 0, ""},
+	{PidTagRwRulesStream, 0x6802, PtypBinary, 0, ""},
+	{PidTagScheduleInfoAppointmentTombstone, 0x686A, PtypBinary, 0, ""},
+	{PidTagScheduleInfoAutoAcceptAppointments, 0x686D, PtypBoolean, 0, ""},
+	{PidTagScheduleInfoDelegateEntryIds, 0x6845, PtypMultipleBinary, 0, ""},
+	{PidTagScheduleInfoDelegateNames, 0x6844, PtypMultipleString, 0, ""},
+	{PidTagScheduleInfoDelegateNamesW, 0x684A, PtypMultipleString, 0, ""},
+	{PidTagScheduleInfoDelegatorWantsCopy, 0x6842, PtypBoolean, 0, ""},
+	{PidTagScheduleInfoDelegatorWantsInfo, 0x684B, PtypBoolean, 0, ""},
+	{PidTagScheduleInfoDisallowOverlappingAppts, 0x686F, PtypBoolean, 0, ""},
+	{PidTagScheduleInfoDisallowRecurringAppts, 0x686E, PtypBoolean, 0, ""},
+	{PidTagScheduleInfoDontMailDelegates, 0x6843, PtypBoolean, 0, ""},
+	{PidTagScheduleInfoFreeBusy, 0x686C, PtypBinary, 0, ""},
+	{PidTagScheduleInfoFreeBusyAway, 0x6856, PtypMultipleBinary, 0, ""},
+	{PidTagScheduleInfoFreeBusyBusy, 0x6854, PtypMultipleBinary, 0, ""},
+	{PidTagScheduleInfoFreeBusyMerged, 0x6850, PtypMultipleBinary, 0, ""},
+	{PidTagScheduleInfoFreeBusyTentative, 0x6852, PtypMultipleBinary, 0, ""},
+	{PidTagScheduleInfoMonthsAway, 0x6855, PtypMultipleInteger32, 0, ""},
+	{PidTagScheduleInfoMonthsBusy, 0x6853, PtypMultipleInteger32, 0, ""},
+	{PidTagScheduleInfoMonthsMerged, 0x684F, PtypMultipleInteger32, 0, ""},
+	{PidTagScheduleInfoMonthsTentative, 0x6851, PtypMultipleInteger32, 0, ""},
+	{PidTagScheduleInfoResourceType, 0x6841, PtypInteger32, 0, ""},
+	{PidTagSchedulePlusFreeBusyEntryId, 0x6622, PtypBinary, 0, ""},
+	{PidTagScriptData, 0x0004, PtypBinary, 0, ""},
+	{PidTagSearchFolderDefinition, 0x6845, PtypBinary, 0, ""},
+	{PidTagSearchFolderEfpFlags, 0x6848, PtypInteger32, 0, ""},
+	{PidTagSearchFolderExpiration, 0x683A, PtypInteger32, 0, ""},
+	{PidTagSearchFolderId, 0x6842, PtypBinary, 0, ""},
+	{PidTagSearchFolderLastUsed, 0x6834, PtypInteger32, 0, ""},
+	{PidTagSearchFolderRecreateInfo, 0x6844, PtypBinary, 0, ""},
+	{PidTagSearchFolderStorageType, 0x6846, PtypInteger32, 0, ""},
+	{PidTagSearchFolderTag, 0x6847, PtypInteger32, 0, ""},
+	{PidTagSearchFolderTemplateId, 0x6841, PtypInteger32, 0, ""},
+	{PidTagSearchKey, 0x300B, PtypBinary, 0, ""},
+	{PidTagSecurityDescriptorAsXml, 0x0E6A, PtypString, 0, ""},
+	{PidTagSelectable, 0x3609, PtypBoolean, 0, ""},
+	{PidTagSenderAddressType, 0x0C1E, PtypString, 0, ""},
+	{PidTagSenderEmailAddress, 0x0C1F, PtypString, 0, ""},
+	{PidTagSenderEntryId, 0x0C19, PtypBinary, 0, ""},
+	{PidTagSenderIdStatus, 0x4079, PtypInteger32, 0, ""},
+	{PidTagSenderName, 0x0C1A, PtypString, 0, ""},
+	{PidTagSenderSearchKey, 0x0C1D, PtypBinary, 0, ""},
+	{PidTagSenderSmtpAddress, 0x5D01, PtypString, 0, ""},
+	{PidTagSenderTelephoneNumber, 0x6802, PtypString, 0, ""},
+	{PidTagSendInternetEncoding, 0x3A71, PtypInteger32, 0, ""},
+	{PidTagSendRichInfo, 0x3A40, PtypBoolean, 0, ""},
+	{PidTagSensitivity, 0x0036, PtypInteger32, 0, ""},
+	{PidTagSentMailSvrEID, 0x6740, PtypServerId, 0, ""},
+	{PidTagSentRepresentingAddressType, 0x0064, PtypString, 0, ""},
+	{PidTagSentRepresentingEmailAddress, 0x0065, PtypString, 0, ""},
+	{PidTagSentRepresentingEntryId, 0x0041, PtypBinary, 0, ""},
+	{PidTagSentRepresentingFlags, 0x401A, PtypInteger32, 0, ""},
+	{PidTagSentRepresentingName, 0x0042, PtypString, 0, ""},
+	{PidTagSentRepresentingSearchKey, 0x003B, PtypBinary, 0, ""},
+	{PidTagSentRepresentingSmtpAddress, 0x5D02, PtypString, 0, ""},
+	{PidTagSerializedReplidGuidMap, 0x6638, PtypBinary, 0, ""},
+	{PidTagSmtpAddress, 0x39FE, PtypString, 0, ""},
+	{PidTagSortLocaleId, 0x6705, PtypInteger32, 0, ""},
+	{PidTagSourceKey, 0x65E0, PtypBinary, 0, ""},
+	{PidTagSpokenName, 0x8CC2, PtypBinary, 0, ""},
+	{PidTagSpouseName, 0x3A48, PtypString, 0, ""},
+	{PidTagStartDate, 0x0060, PtypTime, 0, ""},
+	{PidTagStartDateEtc, 0x301B, PtypBinary, 0, ""},
+	{PidTagStateOrProvince, 0x3A28, PtypString, 0, ""},
+	{PidTagStoreEntryId, 0x0FFB, PtypBinary, 0, ""},
+	{PidTagStoreState, 0x340E, PtypInteger32, 0, ""},
+	{PidTagStoreSupportMask, 0x340D, PtypInteger32, 0, ""},
+	{PidTagStreetAddress, 0x3A29, PtypString, 0, ""},
+	{PidTagSubfolders, 0x360A, PtypBoolean, 0, ""},
+	{PidTagSubject, 0x0037, PtypString, 0, ""},
+	{PidTagSubjectPrefix, 0x003D, PtypString, 0, ""},
+	{PidTagSupplementaryInfo, 0x0C1B, PtypString, 0, ""},
+	{PidTagSurname, 0x3A11, PtypString, 0, ""},
+	{PidTagSwappedToDoData, 0x0E2D, PtypBinary, 0, ""},
+	{PidTagSwappedToDoStore, 0x0E2C, PtypBinary, 0, ""},
+	{PidTagTargetEntryId, 0x3010, PtypBinary, 0, ""},
+	{PidTagTelecommunicationsDeviceForDeafTelephoneNumber, 0x3A4B, PtypString, 0, ""},
+	{PidTagTelexNumber, 0x3A2C, PtypMultipleBinary, 0, ""},
+	{PidTagTemplateData, 0x0001, PtypBinary, 0, ""},
+	{PidTagTemplateid, 0x3902, PtypBinary, 0, ""},
+	{PidTagTextAttachmentCharset, 0x371B, PtypString, 0, ""},
+	{PidTagThumbnailPhoto, 0x8C9E, PtypBinary, 0, ""},
+	{PidTagTitle, 0x3A17, PtypString, 0, ""},
+	{PidTagTnefCorrelationKey, 0x007F, PtypBinary, 0, ""},
+	{PidTagToDoItemFlags, 0x0E2B, PtypInteger32, 0, ""},
+	{PidTagTransmittableDisplayName, 0x3A20, PtypString, 0, ""},
+	{PidTagTransportMessageHeaders, 0x007D, PtypString, 0, ""},
+	{PidTagTrustSender, 0x0E79, PtypInteger32, 0, ""},
+	{PidTagUserCertificate, 0x3A22, PtypBinary, 0, ""},
+	{PidTagUserEntryId, 0x6619, PtypBinary, 0, ""},
+	{PidTagUserX509Certificate, 0x3A70, PtypMultipleBinary, 0, ""},
+	{PidTagViewDescriptorBinary, 0x7001, PtypBinary, 0, ""},
+	{PidTagViewDescriptorName, 0x7006, PtypString, 0, ""},
+	{PidTagViewDescriptorStrings, 0x7002, PtypString, 0, ""},
+	{PidTagViewDescriptorVersion, 0x7007, PtypInteger32, 0, ""},
+	{PidTagVoiceMessageAttachmentOrder, 0x6805, PtypString, 0, ""},
+	{PidTagVoiceMessageDuration, 0x6801, PtypInteger32, 0, ""},
+	{PidTagVoiceMessageSenderName, 0x6803, PtypString, 0, ""},
+	{PidTagWeddingAnniversary, 0x3A41, PtypTime, 0, ""},
+	{PidTagWlinkAddressBookEID, 0x6854, PtypBinary, 0, ""},
+	{PidTagWlinkAddressBookStoreEID, 0x6891, PtypBinary, 0, ""},
+	{PidTagWlinkCalendarColor, 0x6853, PtypInteger32, 0, ""},
+	{PidTagWlinkClientID, 0x6890, PtypBinary, 0, ""},
+	{PidTagWlinkEntryId, 0x684C, PtypBinary, 0, ""},
+	{PidTagWlinkFlags, 0x684A, PtypInteger32, 0, ""},
+	{PidTagWlinkFolderType, 0x684F, PtypBinary, 0, ""},
+	{PidTagWlinkGroupClsid, 0x6850, PtypBinary, 0, ""},
+	{PidTagWlinkGroupHeaderID, 0x6842, PtypBinary, 0, ""},
+	{PidTagWlinkGroupName, 0x6851, PtypString, 0, ""},
+	{PidTagWlinkOrdinal, 0x684B, PtypBinary, 0, ""},
+	{PidTagWlinkRecordKey, 0x684D, PtypBinary, 0, ""},
+	{PidTagWlinkROGroupType, 0x6892, PtypInteger32, 0, ""},
+	{PidTagWlinkSaveStamp, 0x6847, PtypInteger32, 0, ""},
+	{PidTagWlinkSection, 0x6852, PtypInteger32, 0, ""},
+	{PidTagWlinkStoreEntryId, 0x684E, PtypBinary, 0, ""},
+	{PidTagWlinkType, 0x6849, PtypInteger32, 0, ""},
 }
@@ -0,0 +1,75 @@
+package oxmsg
+
+import (
+	"io"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// Flags of a property in the properties stream.
+const (
+	PROPATTR_MANDATORY = 0x00000001
+	PROPATTR_READABLE  = 0x00000002
+	PROPATTR_WRITABLE  = 0x00000004
+)
+
+// Header size of the properties stream, by the kind of object holding it.
+const (
+	headerTopLevel = 32 // Reserved, next recipient ID, next attachment ID, recipient count, attachment count, reserved
+	headerEmbedded = 24 // As top level, without the trailing reserved bytes
+	headerObject   = 8  // Attachment and recipient, reserved only
+
+	propertyEntrySize = 16 // Tag, flags and value
+)
+
+// IsFixedType return true if values of the type are kept in the properties stream, rather than a stream of their own.
+func IsFixedType(propType PropertyType) bool {
+	switch propType {
+	case PtypInteger16, PtypInteger32, PtypFloating32, PtypFloating64, PtypCurrency, PtypFloatingTime,
+		PtypErrorCode, PtypBoolean, PtypInteger64, PtypTime:
+		return true
+	}
+	return false
+}
+
+// readPropertyStream add the fixed size properties of the properties stream of a storage to m, and set the flags of
+// the variable size properties already in m.
+func readPropertyStream(storage *cfb.DirectoryEntry, headerSize int, m map[string][]*Entry, names NameMap) (err error) {
+	entry, err := storage.Lookup(PropertiesStreamName)
+	if err != nil {
+		return
+	}
+
+	s, err := entry.Stream()
+	if err != nil {
+		return
+	}
+
+	b, err := io.ReadAll(s)
+	if err != nil {
+		return
+	}
+	if len(b) < headerSize {
+		return ErrPropertyStream
+	}
+
+	for b = b[headerSize:]; len(b) >= propertyEntrySize; b = b[propertyEntrySize:] {
+		tag := byteOrder.Uint32(b)
+		propID, propType := PropertyID(tag>>16), PropertyType(tag&0xFFFF)
+		flags := byteOrder.Uint32(b[4:])
+
+		if IsFixedType(propType) {
+			e := newFixedEntry(propID, propType, flags, append([]byte(nil), b[8:16]...), names)
+			m[e.Name()] = append(m[e.Name()], e)
+			continue
+		}
+
+		p, _ := names.resolve(propID, propType)
+		for _, e := range m[p.Name] {
+			if e.tag == tag {
+				e.flags = flags
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,196 @@
+package oxmsg
+
+import (
+	"net"
+	"strings"
+	"time"
+)
+
+// Private, shared, loopback, link local, documentation, multicast and other special purpose networks of the IANA
+// IPv4 and IPv6 special-purpose address registries.
+var reservedNetworks = parseNetworks(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.0.0.0/24",
+	"192.0.2.0/24", "192.88.99.0/24", "192.168.0.0/16", "198.18.0.0/15", "198.51.100.0/24", "203.0.113.0/24",
+	"224.0.0.0/4", "240.0.0.0/4",
+	"::/127", "64:ff9b::/96", "64:ff9b:1::/48", "100::/64", "2001::/23", "2001:db8::/32", "fc00::/7",
+	"fe80::/10", "ff00::/8",
+)
+
+var closingDelimiter = map[byte]byte{'"': '"', '[': ']', '<': '>'}
+
+// Hop is a parsed Received field, RFC 5321 section 4.4. The clauses are as written, including comments, eg. From is
+// "mx.example.net (mx.example.net [203.0.113.5])".
+type Hop struct {
+	*Block
+
+	From string
+	By   string
+	Via  string
+	With string
+	ID   string
+	For  string
+	Time time.Time // The zero time if missing or not parsable
+
+	FromIPs    []net.IP      // Addresses of the from clause, usually the connecting host
+	Reserved   []net.IP      // Addresses of FromIPs that are private or reserved, see IsReservedIP
+	Delay      time.Duration // Time since the previous hop with a time, 0 if unknown
+	OutOfOrder bool          // Time is before that of the previous hop, ie. Delay is negative
+}
+
+// ParseReceived parse the value of a Received field. Delay and OutOfOrder are set by Header.Received.
+func ParseReceived(value string) (hop *Hop) {
+	hop = &Hop{Block: &Block{lines: []string{"Received: " + value}}}
+
+	clauses := value
+	if parts := splitStructured(value, ';'); len(parts) > 1 {
+		clauses = strings.Join(parts[:len(parts)-1], ";")
+		hop.Time, _ = parseDate(parts[len(parts)-1])
+	}
+
+	keywords := map[string]*string{
+		"from": &hop.From,
+		"by":   &hop.By,
+		"via":  &hop.Via,
+		"with": &hop.With,
+		"id":   &hop.ID,
+		"for":  &hop.For,
+	}
+
+	var clause *string
+	for _, token := range receivedTokens(clauses) {
+		if c, ok := keywords[strings.ToLower(token)]; ok && *c == "" {
+			clause = c
+			continue
+		}
+
+		if clause == nil {
+			continue // Text before the first clause
+		}
+		if *clause != "" {
+			*clause += " "
+		}
+		*clause += token
+	}
+
+	// Keep "IPv6:" literals, eg. "[IPv6:2001:db8::1]", from being scanned as "6:2001:db8::1"
+	scanIP(strings.NewReplacer("IPv6:", " ", "ipv6:", " ").Replace(hop.From), func(ip net.IP) {
+		hop.FromIPs = append(hop.FromIPs, ip)
+		if IsReservedIP(ip) {
+			hop.Reserved = append(hop.Reserved, ip)
+		}
+	})
+	return
+}
+
+// FromHost return the host name of the from clause, as given by the sender, eg. in HELO.
+func (h *Hop) FromHost() string {
+	return firstToken(h.From)
+}
+
+// ByHost return the host name of the by clause, ie. the receiving host.
+func (h *Hop) ByHost() string {
+	return firstToken(h.By)
+}
+
+// Received return the hops of the Received fields, the first received first.
+func (h *Header) Received() (hops []*Hop) {
+	values := h.Values("Received")
+	for i := len(values) - 1; i >= 0; i-- {
+		hops = append(hops, ParseReceived(values[i]))
+	}
+
+	var previous time.Time
+	for _, hop := range hops {
+		if hop.Time.IsZero() {
+			continue
+		}
+
+		if !previous.IsZero() {
+			hop.Delay = hop.Time.Sub(previous)
+			hop.OutOfOrder = hop.Delay < 0
+		}
+		previous = hop.Time
+	}
+	return
+}
+
+// IsReservedIP report whether the address is private or reserved, ie. not routable on the Internet. IPv4-mapped IPv6
+// addresses are checked as IPv4.
+func IsReservedIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// receivedTokens split the clauses of a Received field into words and comments. Comments, quoted strings, domain
+// literals and angle addresses are kept whole.
+func receivedTokens(s string) (tokens []string) {
+	for i := 0; i < len(s); {
+		if c := s[i]; c == ' ' || c == '\t' || c == '\r' || c == '\n' {
+			i++
+			continue
+		}
+
+		start := i
+		switch s[i] {
+		case '(':
+			for depth := 0; i < len(s); i++ {
+				if s[i] == '\\' {
+					i++
+				} else if s[i] == '(' {
+					depth++
+				} else if s[i] == ')' {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+			i++
+		case '"', '[', '<':
+			end := closingDelimiter[s[i]]
+			for i++; i < len(s) && s[i] != end; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			i++
+		default:
+			for ; i < len(s) && s[i] != ' ' && s[i] != '\t' && s[i] != '\r' && s[i] != '\n' && s[i] != '('; i++ {
+			}
+		}
+
+		if i > len(s) {
+			i = len(s)
+		}
+		tokens = append(tokens, s[start:i])
+	}
+	return
+}
+
+// firstToken return the first word of a clause that is not a comment.
+func firstToken(clause string) string {
+	for _, token := range receivedTokens(clause) {
+		if token[0] != '(' {
+			return token
+		}
+	}
+	return ""
+}
+
+func parseNetworks(cidrs ...string) (networks []*net.IPNet) {
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return
+}
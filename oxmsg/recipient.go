@@ -0,0 +1,95 @@
+package oxmsg
+
+import (
+	"strings"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// RecipientType is the value of PidTagRecipientType, without the MAPI_P1 and MAPI_SUBMITTED flags.
+type RecipientType uint32
+
+const (
+	RecipientOriginator RecipientType = 0x0000
+	RecipientTo         RecipientType = 0x0001
+	RecipientCc         RecipientType = 0x0002
+	RecipientBcc        RecipientType = 0x0003
+
+	recipientTypeMask = 0x0FFFFFFF
+)
+
+func (t RecipientType) String() string {
+	switch t {
+	case RecipientOriginator:
+		return "From"
+	case RecipientTo:
+		return "To"
+	case RecipientCc:
+		return "Cc"
+	case RecipientBcc:
+		return "Bcc"
+	}
+	return "Unknown"
+}
+
+// Recipient is a recipient storage, __recip_version1.0_#XXXXXXXX, of a message.
+type Recipient struct {
+	Type         RecipientType
+	DisplayName  string // PidTagDisplayName, or PidTagRecipientDisplayName if not present
+	SmtpAddress  string // PidTagSmtpAddress
+	EmailAddress string // PidTagEmailAddress, formatted according to AddressType
+	AddressType  string // PidTagAddressType, eg. "SMTP" or "EX"
+
+	object
+}
+
+// Recipients return the recipients of the message, ordered by recipient number.
+func (m *Message) Recipients() (recipients []*Recipient, err error) {
+	storage, err := m.Storage()
+	if err != nil {
+		return
+	}
+
+	for _, child := range children(storage, RecipientPrefix) {
+		recipients = append(recipients, newRecipient(child, m.NameMap()))
+	}
+	return
+}
+
+func newRecipient(storage *cfb.DirectoryEntry, names NameMap) (r *Recipient) {
+	r = &Recipient{object: newObject(storage, headerObject, names)}
+	r.DisplayName = r.string(PidTagDisplayName, PidTagRecipientDisplayName)
+	r.SmtpAddress = r.string(PidTagSmtpAddress)
+	r.EmailAddress = r.string(PidTagEmailAddress)
+	r.AddressType = r.string(PidTagAddressType)
+
+	if e := r.Get(PidTagRecipientType); e != nil {
+		if v, err := e[0].Uint32(); err == nil {
+			r.Type = RecipientType(v & recipientTypeMask)
+		}
+	}
+	return
+}
+
+// Address return the SMTP address of the recipient, if known.
+func (r *Recipient) Address() string {
+	if r.SmtpAddress != "" {
+		return r.SmtpAddress
+	}
+	if strings.EqualFold(r.AddressType, "SMTP") {
+		return r.EmailAddress
+	}
+	return ""
+}
+
+// String return the recipient as "Display Name <address>".
+func (r *Recipient) String() string {
+	address := r.Address()
+	switch {
+	case address == "":
+		return r.DisplayName
+	case r.DisplayName == "" || r.DisplayName == address:
+		return address
+	}
+	return r.DisplayName + " <" + address + ">"
+}
@@ -0,0 +1,120 @@
+package oxmsg
+
+import (
+	"hash/crc32"
+	"io"
+)
+
+// Compressed RTF, [MS-OXRTFCP].
+const (
+	rtfHeaderSize     = 16
+	rtfCompressed     = 0x75465A4C // "LZFu"
+	rtfUncompressed   = 0x414C454D // "MELA"
+	rtfDictionarySize = 4096
+)
+
+// rtfPrebuffer initialize the dictionary of compressed RTF.
+const rtfPrebuffer = "{\\rtf1\\ansi\\mac\\deff0\\deftab720{\\fonttbl;}{\\f0\\fnil \\froman \\fswiss \\fmodern \\fscript " +
+	"\\fdecor MS Sans SerifSymbolArialTimes New RomanCourier{\\colortbl\\red0\\green0\\blue0\r\n\\par " +
+	"\\pard\\plain\\f0\\fs20\\b\\i\\u\\tab\\tx"
+
+// RTFBody return the decompressed RTF body of the message, PidTagRtfCompressed.
+func (m *Message) RTFBody() (rtf []byte, err error) {
+	entries := m.Get(PidTagRtfCompressed)
+	if entries == nil {
+		return nil, ErrPropertyNotFound
+	}
+
+	r, err := entries[0].Reader()
+	if err != nil {
+		return
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	return DecompressRTF(b)
+}
+
+// DecompressRTF decompress a compressed RTF stream, verifying the CRC of compressed content.
+func DecompressRTF(b []byte) (rtf []byte, err error) {
+	if len(b) < rtfHeaderSize {
+		return nil, ErrRTFHeader
+	}
+
+	compSize := byteOrder.Uint32(b)
+	rawSize := byteOrder.Uint32(b[4:])
+	compType := byteOrder.Uint32(b[8:])
+	crc := byteOrder.Uint32(b[12:])
+	if compSize < rtfHeaderSize-4 || uint64(compSize)+4 > uint64(len(b)) {
+		return nil, ErrRTFHeader
+	}
+	data := b[rtfHeaderSize : compSize+4]
+
+	switch compType {
+	case rtfUncompressed:
+		if uint64(rawSize) > uint64(len(data)) {
+			return nil, ErrRTFData
+		}
+		return append([]byte(nil), data[:rawSize]...), nil
+	case rtfCompressed:
+		if rtfCRC(data) != crc {
+			return nil, ErrRTFCRC
+		}
+	default:
+		return nil, ErrRTFHeader
+	}
+
+	var dictionary [rtfDictionarySize]byte
+	copy(dictionary[:], rtfPrebuffer)
+	write := len(rtfPrebuffer)
+
+	// The raw size is not trusted beyond what the data can expand to, a 2 byte reference is at most 17 bytes
+	size := uint64(rawSize)
+	if max := uint64(len(data)) * 9; size > max {
+		size = max
+	}
+	rtf = make([]byte, 0, size)
+	for i := 0; i < len(data); {
+		control := data[i]
+		i++
+
+		for bit := uint(0); bit < 8; bit++ {
+			if i >= len(data) {
+				return rtf, nil // Input may end in the middle of a run
+			}
+
+			if control&(1<<bit) == 0 {
+				dictionary[write] = data[i]
+				write = (write + 1) % rtfDictionarySize
+				rtf = append(rtf, data[i])
+				i++
+				continue
+			}
+
+			if i+1 >= len(data) {
+				return nil, ErrRTFData
+			}
+			reference := int(data[i])<<8 | int(data[i+1])
+			i += 2
+
+			offset, length := reference>>4, reference&0x0F+2
+			if offset == write {
+				return rtf, nil // End of content
+			}
+
+			for j := 0; j < length; j++ {
+				c := dictionary[(offset+j)%rtfDictionarySize]
+				dictionary[write] = c
+				write = (write + 1) % rtfDictionarySize
+				rtf = append(rtf, c)
+			}
+		}
+	}
+	return
+}
+
+// rtfCRC compute the CRC of compressed RTF, a CRC-32 without pre and post inversion.
+func rtfCRC(b []byte) uint32 {
+	return ^crc32.Update(0xFFFFFFFF, crc32.IEEETable, b)
+}
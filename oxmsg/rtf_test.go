@@ -0,0 +1,132 @@
+package oxmsg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// compressRTF create compressed RTF using literal runs only.
+func compressRTF(rtf []byte) []byte {
+	var data []byte
+	for i := 0; i < len(rtf); i += 8 {
+		end := i + 8
+		if end > len(rtf) {
+			end = len(rtf)
+		}
+		data = append(data, 0x00)
+		data = append(data, rtf[i:end]...)
+	}
+
+	// End reference, to the dictionary write position
+	write := (len(rtfPrebuffer) + len(rtf)) % rtfDictionarySize
+	if len(rtf)%8 == 0 {
+		data = append(data, 0x01)
+	} else {
+		data[len(data)-1-len(rtf)%8] |= 1 << uint(len(rtf)%8)
+	}
+	data = append(data, byte(write>>4), byte(write<<4))
+
+	header := make([]byte, rtfHeaderSize)
+	byteOrder.PutUint32(header, uint32(len(data)+rtfHeaderSize-4))
+	byteOrder.PutUint32(header[4:], uint32(len(rtf)))
+	byteOrder.PutUint32(header[8:], rtfCompressed)
+	byteOrder.PutUint32(header[12:], rtfCRC(data))
+	return append(header, data...)
+}
+
+func TestDecompressRTF(t *testing.T) {
+	uncompressed := append([]byte{0x13, 0, 0, 0, 0x07, 0, 0, 0, 'M', 'E', 'L', 'A', 0, 0, 0, 0}, "{\\rtf1}"...)
+	oversized := compressRTF([]byte("{\\rtf1 raw size}"))
+	byteOrder.PutUint32(oversized[4:], 0xFFFFFFFF)
+
+	tests := []struct {
+		compressed []byte
+		expect     string
+		err        error
+	}{
+		{ // [MS-OXRTFCP] 3.1.1, no dictionary references
+			[]byte{0x2d, 0x00, 0x00, 0x00, 0x2b, 0x00, 0x00, 0x00, 0x4c, 0x5a, 0x46, 0x75, 0xf1, 0xc5, 0xc7, 0xa7, 0x03, 0x00,
+				0x0a, 0x00, 0x72, 0x63, 0x70, 0x67, 0x31, 0x32, 0x35, 0x42, 0x32, 0x0a, 0xf3, 0x20, 0x68, 0x65, 0x6c, 0x09,
+				0x00, 0x20, 0x62, 0x77, 0x05, 0xb0, 0x6c, 0x64, 0x7d, 0x0a, 0x80, 0x0f, 0xa0},
+			"{\\rtf1\\ansi\\ansicpg1252\\pard hello world}\r\n", nil,
+		},
+		{ // [MS-OXRTFCP] 3.1.2, reference crossing the write position
+			[]byte{0x1a, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x4c, 0x5a, 0x46, 0x75, 0xe2, 0xd4, 0x4b, 0x51, 0x41, 0x00,
+				0x04, 0x20, 0x57, 0x58, 0x59, 0x5a, 0x0d, 0x6e, 0x7d, 0x01, 0x0e, 0xb0},
+			"{\\rtf1 WXYZWXYZWXYZWXYZWXYZ}", nil,
+		},
+		{uncompressed, "{\\rtf1}", nil},
+		{compressRTF([]byte("{\\rtf1 literal only}")), "{\\rtf1 literal only}", nil},
+		{compressRTF([]byte("{\\rtf1 8 chars}")), "{\\rtf1 8 chars}", nil},
+		{oversized, "{\\rtf1 raw size}", nil},
+		{[]byte{1, 2, 3}, "", ErrRTFHeader},
+		{[]byte{0x0c, 0, 0, 0, 0, 0, 0, 0, 'X', 'X', 'X', 'X', 0, 0, 0, 0}, "", ErrRTFHeader},
+		{[]byte{0x0d, 0, 0, 0, 1, 0, 0, 0, 'L', 'Z', 'F', 'u', 0, 0, 0, 0, 1}, "", ErrRTFCRC},
+	}
+
+	for testId, test := range tests {
+		got, err := DecompressRTF(test.compressed)
+		if err != test.err {
+			t.Errorf("Test [%d]: Expected error [%v], got [%v]", testId, test.err, err)
+		} else if err == nil && string(got) != test.expect {
+			t.Errorf("Test [%d]: Expected [%q], got [%q]", testId, test.expect, got)
+		} else if cap(got) > 9*len(test.compressed) {
+			t.Errorf("Test [%d]: Expected capacity at most [%d], got [%d]", testId, 9*len(test.compressed), cap(got))
+		}
+	}
+}
+
+func TestDecapsulate(t *testing.T) {
+	tests := []struct {
+		rtf    string
+		expect string
+		isHTML bool
+		err    error
+	}{
+		{ // [MS-OXRTFEX] 4.1, HTML
+			`{\rtf1\ansi\ansicpg1252\fromhtml1 \deff0{\fonttbl{\f0\fswiss Arial;}}` +
+				`{\*\htmltag19 <html>}{\*\htmltag50 <body>}\htmlrtf {\htmlrtf0 ` +
+				`{\*\htmltag64 <p>}Caf\'e9 \{ok\}\htmlrtf \par\htmlrtf0 {\*\htmltag72 </p>}\par` +
+				`\htmlrtf }\htmlrtf0 {\*\htmltag58 </body>}{\*\htmltag27 </html>}}`,
+			"<html><body><p>Café {ok}</p>\r\n</body></html>", true, nil,
+		},
+		{ // Plain text
+			`{\rtf1\ansi\ansicpg1251\fromtext \deff0{\fonttbl{\f0\fmodern Courier;}}\f0 ` +
+				`\'cf\'f0\'e8\'e2\'e5\'f2\par\uc1\u8364?\tab Euro\par}`,
+			"Привет\r\n€\tEuro\r\n", false, nil,
+		},
+		{`{\rtf1\fromtext \uc1\u-10179?\u-8704? smile}`, "\U0001F600 smile", false, nil},
+		{`{\rtf1\fromtext \uc1\u-10179?\u65?}`, "\uFFFDA", false, nil},
+		{`{\rtf1\ansi\deff0 Not encapsulated\par}`, "", false, ErrNotEncapsulated},
+	}
+
+	for testId, test := range tests {
+		got, isHTML, err := Decapsulate([]byte(test.rtf))
+		if err != test.err {
+			t.Errorf("Test [%d]: Expected error [%v], got [%v]", testId, test.err, err)
+		} else if err == nil && (got != test.expect || isHTML != test.isHTML) {
+			t.Errorf("Test [%d]: Expected [%q %v], got [%q %v]", testId, test.expect, test.isHTML, got, isHTML)
+		}
+	}
+}
+
+func TestRTFBody(t *testing.T) {
+	rtf := []byte(`{\rtf1\ansi\fromtext Hello\par}`)
+
+	d, _ := cfb.New()
+	root, _ := d.Root()
+	root.AddStream(stream(0x1009, PtypBinary), compressRTF(rtf))
+
+	m := reopen(t, d)
+	got, err := m.RTFBody()
+	if err != nil || !bytes.Equal(got, rtf) {
+		t.Errorf("Expected [%s], got [%s] error [%v]", rtf, got, err)
+	}
+
+	body, isHTML, err := m.OriginalBody()
+	if err != nil || body != "Hello\r\n" || isHTML {
+		t.Errorf("Expected [Hello], got [%q %v] error [%v]", body, isHTML, err)
+	}
+}
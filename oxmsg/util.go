@@ -2,36 +2,82 @@ package oxmsg
 
 import (
 	"regexp"
+	"strings"
+
+	"github.com/xianhammer/format/cfb"
 )
 
 var propertyByID map[PropertyID]*Property
+var propertyByTag map[uint32]*Property
 var propertyByName map[string]*Property
+var propertyByLID map[lidKey]*Property
+var propertyByString map[stringKey]*Property
 
-const sPrefix = "Received:"
+// lidKey identify a numeric named property, LIDs are only unique within a property set.
+type lidKey struct {
+	guid cfb.GUID
+	lid  uint32
+}
+
+// stringKey identify a string named property by the property set and lower case name.
+type stringKey struct {
+	guid cfb.GUID
+	name string
+}
 
-var rContinuedLine = regexp.MustCompile(`^(\s)+`)
 var rMultipleSpaces = regexp.MustCompile(`\s+`)
 
 func init() {
 	propertyByID = make(map[PropertyID]*Property)
+	propertyByTag = make(map[uint32]*Property)
 	propertyByName = make(map[string]*Property)
+	propertyByLID = make(map[lidKey]*Property)
+	propertyByString = make(map[stringKey]*Property)
 	for i := range Properties {
 		p := Properties[i]
-		if p.ID < PsetLAST {
+		switch {
+		case strings.HasPrefix(p.Name, "PidLid"):
+			propertyByLID[lidKey{p.Guid(), uint32(p.ID)}] = &p
+		case strings.HasPrefix(p.Name, "PidName"):
+			key := stringKey{p.Guid(), strings.ToLower(p.StringName)}
+			if propertyByString[key] == nil {
+				propertyByString[key] = &p // PidNameCalendarLocationUrl and PidNameLocationUrl share the name
+			}
+		case p.ID < PsetLAST:
 			propertyByID[p.ID] = &p
+			propertyByTag[uint32(p.ID)<<16|uint32(p.Type)] = &p
 		}
 		propertyByName[p.Name] = &p
 	}
 }
 
+// GetPropertyByID return the PidTag property of the ID.
 func GetPropertyByID(id PropertyID) *Property {
 	return propertyByID[id]
 }
 
+// GetPropertyByTag return the property of both ID and type, eg. PidTagAttachDataBinary and PidTagAttachDataObject
+// share the ID 0x3701.
+func GetPropertyByTag(id PropertyID, propType PropertyType) *Property {
+	return propertyByTag[uint32(id)<<16|uint32(propType)]
+}
+
 func GetPropertyByName(name string) *Property {
 	return propertyByName[name]
 }
 
+// GetPropertyByLID return the PidLid property of a numeric named property of the property set, eg. 0x820D in
+// PSETID_Appointment is PidLidAppointmentStartWhole.
+func GetPropertyByLID(guid cfb.GUID, lid uint32) *Property {
+	return propertyByLID[lidKey{guid, lid}]
+}
+
+// GetPropertyByString return the PidName property of a string named property of the property set, eg. "X-CallID"
+// in PS_INTERNET_HEADERS is PidNameXCallId. Names are matched case insensitive, as header names.
+func GetPropertyByString(guid cfb.GUID, name string) *Property {
+	return propertyByString[stringKey{guid, strings.ToLower(name)}]
+}
+
 func TrimMultipleSpaces(s string) string {
 	return rMultipleSpaces.ReplaceAllString(s, " ")
 }
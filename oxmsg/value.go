@@ -0,0 +1,270 @@
+package oxmsg
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/xianhammer/format/cfb"
+)
+
+// OLE automation dates, PtypFloatingTime, count days from December 30, 1899.
+var oleEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// Currency is a PtypCurrency value, a fixed point number scaled by 10000.
+type Currency int64
+
+func (c Currency) Float64() float64 {
+	return float64(c) / 10000
+}
+
+func (c Currency) String() string {
+	sign, v := "", int64(c)
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	return fmt.Sprintf("%s%d.%04d", sign, v/10000, v%10000)
+}
+
+// Value decode the property value by its type:
+//
+//	PtypInteger16, PtypInteger32 and PtypInteger64 as int16, int32 and int64
+//	PtypFloating32 and PtypFloating64 as float32 and float64
+//	PtypCurrency as Currency
+//	PtypFloatingTime and PtypTime as time.Time
+//	PtypErrorCode as uint32
+//	PtypBoolean as bool
+//	PtypGuid as cfb.GUID
+//	PtypString and PtypString8 as string
+//	PtypObject as the *cfb.DirectoryEntry of the storage
+//	PtypNull and PtypUnspecified as nil
+//	PtypBinary and any other type as []byte
+//
+// Multiple valued types decode as slices of the above, ie. PtypMultipleString as []string.
+func (e *Entry) Value() (v interface{}, err error) {
+	if e.property == nil {
+		return nil, ErrPropertyNotFound
+	}
+
+	propType := e.property.Type
+	switch propType {
+	case PtypNull, PtypUnspecified:
+		return nil, nil
+	case PtypObject:
+		if !e.IsStorage() {
+			return nil, ErrPropertyType
+		}
+		return e.DirectoryEntry, nil
+	case PtypString, PtypString8:
+		v, err = e.String()
+		if err == nil {
+			v = strings.TrimRight(v.(string), "\x00")
+		}
+		return
+	case PtypMultipleString, PtypMultipleString8, PtypMultipleBinary:
+		return e.variableValues()
+	}
+
+	r, err := e.Reader()
+	if err != nil {
+		return
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+
+	if propType&multipleFlag == 0 {
+		if size := fixedSize(propType); size > len(b) {
+			return nil, ErrPropertyType
+		} else if size > 0 {
+			b = b[:size]
+		}
+		return decodeValue(propType, b), nil
+	}
+
+	size := fixedSize(propType &^ multipleFlag)
+	if size == 0 {
+		return b, nil // Unknown multiple valued type
+	}
+
+	values := make([]interface{}, 0, len(b)/size)
+	for ; len(b) >= size; b = b[size:] {
+		values = append(values, decodeValue(propType&^multipleFlag, b[:size]))
+	}
+	return typedSlice(propType&^multipleFlag, values), nil
+}
+
+// multipleFlag is set in the type of multiple valued properties, eg. PtypMultipleInteger32 is PtypInteger32|0x1000.
+const multipleFlag PropertyType = 0x1000
+
+// fixedSize return the size of a single value of the type, 0 if variable.
+func fixedSize(propType PropertyType) int {
+	switch propType {
+	case PtypInteger16, PtypBoolean:
+		return 2
+	case PtypInteger32, PtypFloating32, PtypErrorCode:
+		return 4
+	case PtypInteger64, PtypFloating64, PtypCurrency, PtypFloatingTime, PtypTime:
+		return 8
+	case PtypGuid:
+		return 16
+	}
+	return 0
+}
+
+func decodeValue(propType PropertyType, b []byte) interface{} {
+	switch propType {
+	case PtypInteger16:
+		return int16(byteOrder.Uint16(b))
+	case PtypInteger32:
+		return int32(byteOrder.Uint32(b))
+	case PtypInteger64:
+		return int64(byteOrder.Uint64(b))
+	case PtypFloating32:
+		return math.Float32frombits(byteOrder.Uint32(b))
+	case PtypFloating64:
+		return math.Float64frombits(byteOrder.Uint64(b))
+	case PtypCurrency:
+		return Currency(byteOrder.Uint64(b))
+	case PtypFloatingTime:
+		return oleTime(math.Float64frombits(byteOrder.Uint64(b)))
+	case PtypTime:
+		return cfb.FILETIME{LowDateTime: byteOrder.Uint32(b), HighDateTime: byteOrder.Uint32(b[4:])}.Time()
+	case PtypErrorCode:
+		return byteOrder.Uint32(b)
+	case PtypBoolean:
+		return b[0] != 0
+	case PtypGuid:
+		return readGUID(b)
+	}
+	return b
+}
+
+// typedSlice convert decoded values into a slice of their type, eg. []int32.
+func typedSlice(propType PropertyType, values []interface{}) interface{} {
+	switch propType {
+	case PtypInteger16:
+		s := make([]int16, len(values))
+		for i, v := range values {
+			s[i] = v.(int16)
+		}
+		return s
+	case PtypInteger32:
+		s := make([]int32, len(values))
+		for i, v := range values {
+			s[i] = v.(int32)
+		}
+		return s
+	case PtypInteger64:
+		s := make([]int64, len(values))
+		for i, v := range values {
+			s[i] = v.(int64)
+		}
+		return s
+	case PtypFloating32:
+		s := make([]float32, len(values))
+		for i, v := range values {
+			s[i] = v.(float32)
+		}
+		return s
+	case PtypFloating64:
+		s := make([]float64, len(values))
+		for i, v := range values {
+			s[i] = v.(float64)
+		}
+		return s
+	case PtypCurrency:
+		s := make([]Currency, len(values))
+		for i, v := range values {
+			s[i] = v.(Currency)
+		}
+		return s
+	case PtypFloatingTime, PtypTime:
+		s := make([]time.Time, len(values))
+		for i, v := range values {
+			s[i] = v.(time.Time)
+		}
+		return s
+	case PtypGuid:
+		s := make([]cfb.GUID, len(values))
+		for i, v := range values {
+			s[i] = v.(cfb.GUID)
+		}
+		return s
+	}
+	return values
+}
+
+// variableValues read the values of PtypMultipleString, PtypMultipleString8 and PtypMultipleBinary. The entry
+// stream hold the value lengths, while the values are in streams of their own, suffixed by the value index.
+func (e *Entry) variableValues() (v interface{}, err error) {
+	if e.IsFixed() || e.Parent() == nil {
+		return nil, ErrPropertyType
+	}
+
+	r, err := e.Reader()
+	if err != nil {
+		return
+	}
+	lengths, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+
+	propType := e.property.Type
+	size := 4
+	if propType == PtypMultipleBinary {
+		size = 8
+	}
+
+	var values [][]byte
+	for i := 0; i < len(lengths)/size; i++ {
+		var b []byte
+		if b, err = readAll(e.Parent(), fmt.Sprintf("%s-%08X", e.DirectoryEntry.Name(), i)); err != nil {
+			return
+		}
+		values = append(values, b)
+	}
+
+	switch propType {
+	case PtypMultipleBinary:
+		return values, nil
+	case PtypMultipleString:
+		s := make([]string, len(values))
+		for i, b := range values {
+			s[i] = unicodeString(b)
+		}
+		return s, nil
+	}
+
+	s := make([]string, len(values))
+	for i, b := range values {
+		s[i] = strings.TrimRight(string(b), "\x00")
+	}
+	return s, nil
+}
+
+// unicodeString decode UTF-16LE, up to any null terminator.
+func unicodeString(b []byte) string {
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		c := byteOrder.Uint16(b[i:])
+		if c == 0 {
+			break
+		}
+		u = append(u, c)
+	}
+	return string(utf16.Decode(u))
+}
+
+// oleTime return the time of an OLE automation date, days since oleEpoch with the time of day as fraction. The whole
+// days are added as a date, as a Duration only cover about 292 years, eg. Outlook use 4501-01-01 for "none". Negative
+// dates count days back, but the time of day forward, eg. -1.25 is 1899-12-29 06:00.
+func oleTime(days float64) time.Time {
+	whole, frac := math.Modf(days)
+	return oleEpoch.AddDate(0, 0, int(whole)).Add(time.Duration(math.Abs(frac) * float64(24*time.Hour)))
+}